@@ -0,0 +1,95 @@
+package lol
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/dghubble/sling"
+)
+
+// Account provides methods to interface with riot account resource
+type Account struct {
+	sling *sling.Sling
+}
+
+type AccountDTO struct {
+	Puuid    string `json:"puuid"`
+	GameName string `json:"gameName"`
+	TagLine  string `json:"tagLine"`
+}
+
+type ActiveShardDTO struct {
+	Puuid       string `json:"puuid"`
+	Game        string `json:"game"`
+	ActiveShard string `json:"activeShard"`
+}
+
+// NewAccount returns a new Account, a sling based on a platform is routed to the regional host
+// account-v1 serves that platform from
+func NewAccount(sling *sling.Sling) *Account {
+	return &Account{sling: routedSling(sling, accountRoute).Path("riot/account/v1/")}
+}
+
+// AccountByPUUID GET /riot/account/v1/accounts/by-puuid/{puuid}
+func (a *Account) AccountByPUUID(puuid string) (*AccountDTO, *http.Response, error) {
+	dto := new(AccountDTO)
+	var reqErr error
+	resp, err := a.sling.New().Get("accounts/by-puuid/"+puuid).Receive(dto, reqErr)
+	if err != nil {
+		return nil, resp, err
+	}
+	return dto, resp, reqErr
+}
+
+// AccountByRiotID GET /riot/account/v1/accounts/by-riot-id/{gameName}/{tagLine}
+func (a *Account) AccountByRiotID(gameName, tagLine string) (*AccountDTO, *http.Response, error) {
+	dto := new(AccountDTO)
+	var reqErr error
+	endpoint := "accounts/by-riot-id/" + url.PathEscape(gameName) + "/" + url.PathEscape(tagLine)
+	resp, err := a.sling.New().Get(endpoint).Receive(dto, reqErr)
+	if err != nil {
+		return nil, resp, err
+	}
+	return dto, resp, reqErr
+}
+
+// ActiveShard GET /riot/account/v1/active-shards/by-game/{game}/by-puuid/{puuid}
+func (a *Account) ActiveShard(game, puuid string) (*ActiveShardDTO, *http.Response, error) {
+	dto := new(ActiveShardDTO)
+	var reqErr error
+	resp, err := a.sling.New().Get("active-shards/by-game/"+game+"/by-puuid/"+puuid).Receive(dto, reqErr)
+	if err != nil {
+		return nil, resp, err
+	}
+	return dto, resp, reqErr
+}
+
+// ParseRiotID splits a Riot ID written as gameName#tagLine
+func ParseRiotID(riotID string) (gameName, tagLine string, err error) {
+	i := strings.LastIndex(riotID, "#")
+	if i <= 0 || i == len(riotID)-1 {
+		return "", "", errors.New("lol: riot id must be written as gameName#tagLine")
+	}
+	return riotID[:i], riotID[i+1:], nil
+}
+
+// SummonerByRiotID looks up the account for riotID, written as gameName#tagLine, then the summoner
+// for its PUUID on the client platform. The response of whichever call did not succeed is returned,
+// an account lookup that does not succeed is an error.
+func (c *Client) SummonerByRiotID(riotID string) (*SummonerDTO, *http.Response, error) {
+	gameName, tagLine, err := ParseRiotID(riotID)
+	if err != nil {
+		return nil, nil, err
+	}
+	account, resp, err := c.AccountByRiotID(gameName, tagLine)
+	if err != nil {
+		return nil, resp, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, resp, fmt.Errorf("lol: account lookup failed: %s", resp.Status)
+	}
+	return c.SummonerByPUUID(account.Puuid)
+}
@@ -0,0 +1,200 @@
+package lol
+
+import (
+	"log"
+	"net/http"
+	"testing"
+
+	"github.com/dnaeon/go-vcr/recorder"
+)
+
+func TestAccountByPUUID(t *testing.T) {
+	rec, err := recorder.New("cassettes/riot/account-v1/account-by-puuid")
+	if err != nil {
+		log.Fatal(err)
+	} else {
+		rec.SetMatcher(matchWithoutToken)
+		httpClient = &http.Client{Transport: rec}
+	}
+	rec.AddFilter(removeToken)
+	defer rec.Stop()
+	cli, err := NewClient(testToken, WithHTTPClient(httpClient))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	dto, resp, err := cli.AccountByPUUID(encryptedPUUID)
+	if resp.StatusCode != 200 {
+		t.Errorf("\nExpected: 200 status code\nActual: %d status code", resp.StatusCode)
+		return
+	}
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	expected := "ilikeduck"
+	actual := dto.GameName
+	if expected != actual {
+		t.Errorf("\nExpected: %s\nActual: %s\n", expected, actual)
+		return
+	}
+}
+
+func TestAccountByRiotID(t *testing.T) {
+	rec, err := recorder.New("cassettes/riot/account-v1/account-by-riot-id")
+	if err != nil {
+		log.Fatal(err)
+	} else {
+		rec.SetMatcher(matchWithoutToken)
+		httpClient = &http.Client{Transport: rec}
+	}
+	rec.AddFilter(removeToken)
+	defer rec.Stop()
+	cli, err := NewClient(testToken, WithHTTPClient(httpClient))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	dto, resp, err := cli.AccountByRiotID("ilikeduck", "NA1")
+	if resp.StatusCode != 200 {
+		t.Errorf("\nExpected: 200 status code\nActual: %d status code", resp.StatusCode)
+		return
+	}
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	expected := encryptedPUUID
+	actual := dto.Puuid
+	if expected != actual {
+		t.Errorf("\nExpected: %s\nActual: %s\n", expected, actual)
+		return
+	}
+}
+
+func TestActiveShard(t *testing.T) {
+	rec, err := recorder.New("cassettes/riot/account-v1/active-shard")
+	if err != nil {
+		log.Fatal(err)
+	} else {
+		rec.SetMatcher(matchWithoutToken)
+		httpClient = &http.Client{Transport: rec}
+	}
+	rec.AddFilter(removeToken)
+	defer rec.Stop()
+	cli, err := NewClient(testToken, WithHTTPClient(httpClient))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	dto, resp, err := cli.ActiveShard("val", encryptedPUUID)
+	if resp.StatusCode != 200 {
+		t.Errorf("\nExpected: 200 status code\nActual: %d status code", resp.StatusCode)
+		return
+	}
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	expected := "na"
+	actual := dto.ActiveShard
+	if expected != actual {
+		t.Errorf("\nExpected: %s\nActual: %s\n", expected, actual)
+		return
+	}
+}
+
+func TestSummonerByRiotID(t *testing.T) {
+	rec, err := recorder.New("cassettes/riot/account-v1/summoner-by-riot-id")
+	if err != nil {
+		log.Fatal(err)
+	} else {
+		rec.SetMatcher(matchWithoutToken)
+		httpClient = &http.Client{Transport: rec}
+	}
+	rec.AddFilter(removeToken)
+	defer rec.Stop()
+	cli, err := NewClient(testToken, WithHTTPClient(httpClient))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	dto, resp, err := cli.SummonerByRiotID("ilikeduck#NA1")
+	if resp.StatusCode != 200 {
+		t.Errorf("\nExpected: 200 status code\nActual: %d status code", resp.StatusCode)
+		return
+	}
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	expected := encryptedSummonerID
+	actual := dto.ID
+	if expected != actual {
+		t.Errorf("\nExpected: %s\nActual: %s\n", expected, actual)
+		return
+	}
+}
+
+func TestSummonerByRiotIDNotFound(t *testing.T) {
+	rec, err := recorder.New("cassettes/riot/account-v1/summoner-by-riot-id-not-found")
+	if err != nil {
+		log.Fatal(err)
+	} else {
+		rec.SetMatcher(matchWithoutToken)
+		httpClient = &http.Client{Transport: rec}
+	}
+	rec.AddFilter(removeToken)
+	defer rec.Stop()
+	cli, err := NewClient(testToken, WithHTTPClient(httpClient))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	dto, resp, err := cli.SummonerByRiotID("nobody#NA1")
+	if resp.StatusCode != 404 {
+		t.Errorf("\nExpected: 404 status code\nActual: %d status code", resp.StatusCode)
+		return
+	}
+	expected := "lol: account lookup failed: 404 Not Found"
+	if err == nil || err.Error() != expected {
+		t.Errorf("\nExpected: %s\nActual: %v\n", expected, err)
+		return
+	}
+	if dto != nil {
+		t.Errorf("\nExpected: nil summoner\nActual: %v\n", dto)
+		return
+	}
+}
+
+func TestParseRiotID(t *testing.T) {
+	gameName, tagLine, err := ParseRiotID("i like#duck#NA1")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	expected := "i like#duck"
+	actual := gameName
+	if expected != actual {
+		t.Errorf("\nExpected: %s\nActual: %s\n", expected, actual)
+		return
+	}
+	expected = "NA1"
+	actual = tagLine
+	if expected != actual {
+		t.Errorf("\nExpected: %s\nActual: %s\n", expected, actual)
+		return
+	}
+
+	for _, riotID := range []string{"ilikeduck", "#NA1", "ilikeduck#"} {
+		if _, _, err := ParseRiotID(riotID); err == nil {
+			t.Errorf("\nExpected: error for %s\nActual: nil\n", riotID)
+			return
+		}
+	}
+}
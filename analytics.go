@@ -0,0 +1,192 @@
+package lol
+
+import (
+	"time"
+)
+
+// RemakeDuration is the game length below which a match counts as a remake. Remakes can be
+// called until three minutes in, the margin covers the vote and the nexus falling.
+const RemakeDuration = 5 * time.Minute
+
+// ParticipantAnalytics holds the stats derived from a participant's ParticipantStatsDTO.
+// Shares are fractions of the participant's team total between 0 and 1.
+type ParticipantAnalytics struct {
+	ParticipantID        int
+	TeamID               int
+	KDA                  float64
+	KillParticipation    float64
+	CSPerMin             float64
+	GoldPerMin           float64
+	DamageShare          float64
+	DamageTakenShare     float64
+	VisionPerMin         float64
+	ObjectiveDamageShare float64
+	FirstBloodKill       bool
+	FirstBloodAssist     bool
+}
+
+// TeamAnalytics holds the stats of a team summed over its participants.
+// Shares are fractions of the match total between 0 and 1.
+type TeamAnalytics struct {
+	TeamID               int
+	Win                  bool
+	Kills                int
+	Deaths               int
+	Assists              int
+	KDA                  float64
+	CSPerMin             float64
+	GoldPerMin           float64
+	DamageShare          float64
+	DamageTakenShare     float64
+	VisionPerMin         float64
+	ObjectiveDamageShare float64
+	FirstBlood           bool
+}
+
+// MatchAnalytics holds the derived stats of every participant and team of a match
+type MatchAnalytics struct {
+	Duration time.Duration
+	// Remake is set for matches shorter than RemakeDuration, their stats say little about the players
+	Remake       bool
+	Participants []ParticipantAnalytics
+	Teams        []TeamAnalytics
+}
+
+// teamTotals sums the stats shares and kill participation are computed from
+type teamTotals struct {
+	kills, deaths, assists, cs, gold       int
+	damage, damageTaken, vision, objective int
+}
+
+// Duration returns the game length, gameDuration is in seconds for match-v4
+func (m *MatchDTO) Duration() time.Duration {
+	return time.Duration(m.GameDuration) * time.Second
+}
+
+// IsRemake reports whether the match ended before RemakeDuration
+func (m *MatchDTO) IsRemake() bool {
+	return m.Duration() < RemakeDuration
+}
+
+// Analytics derives per participant and per team stats from the match. Per minute stats use the
+// exact game length so short games are not rounded up, and are zero when the length is unknown.
+func (m *MatchDTO) Analytics() *MatchAnalytics {
+	a := &MatchAnalytics{
+		Duration:     m.Duration(),
+		Remake:       m.IsRemake(),
+		Participants: make([]ParticipantAnalytics, 0, len(m.Participants)),
+		Teams:        make([]TeamAnalytics, 0, len(m.Teams)),
+	}
+	minutes := a.Duration.Minutes()
+
+	totals := map[int]*teamTotals{}
+	var match teamTotals
+	for _, p := range m.Participants {
+		t, ok := totals[p.TeamID]
+		if !ok {
+			t = &teamTotals{}
+			totals[p.TeamID] = t
+		}
+		s := p.Stats
+		for _, sum := range []*teamTotals{t, &match} {
+			sum.kills += s.Kills
+			sum.deaths += s.Deaths
+			sum.assists += s.Assists
+			sum.cs += s.TotalMinionsKilled + s.NeutralMinionsKilled
+			sum.gold += s.GoldEarned
+			sum.damage += s.TotalDamageDealtToChampions
+			sum.damageTaken += s.TotalDamageTaken
+			sum.vision += s.VisionScore
+			sum.objective += s.DamageDealtToObjectives
+		}
+	}
+
+	for _, p := range m.Participants {
+		s := p.Stats
+		t := totals[p.TeamID]
+		a.Participants = append(a.Participants, ParticipantAnalytics{
+			ParticipantID:        p.ParticipantID,
+			TeamID:               p.TeamID,
+			KDA:                  KDA(s.Kills, s.Deaths, s.Assists),
+			KillParticipation:    ratio(s.Kills+s.Assists, t.kills),
+			CSPerMin:             perMinute(s.TotalMinionsKilled+s.NeutralMinionsKilled, minutes),
+			GoldPerMin:           perMinute(s.GoldEarned, minutes),
+			DamageShare:          ratio(s.TotalDamageDealtToChampions, t.damage),
+			DamageTakenShare:     ratio(s.TotalDamageTaken, t.damageTaken),
+			VisionPerMin:         perMinute(s.VisionScore, minutes),
+			ObjectiveDamageShare: ratio(s.DamageDealtToObjectives, t.objective),
+			FirstBloodKill:       s.FirstBloodKill,
+			FirstBloodAssist:     s.FirstBloodAssist,
+		})
+	}
+
+	for _, team := range m.Teams {
+		t, ok := totals[team.TeamID]
+		if !ok {
+			t = &teamTotals{}
+		}
+		a.Teams = append(a.Teams, TeamAnalytics{
+			TeamID:               team.TeamID,
+			Win:                  team.Win == "Win",
+			Kills:                t.kills,
+			Deaths:               t.deaths,
+			Assists:              t.assists,
+			KDA:                  KDA(t.kills, t.deaths, t.assists),
+			CSPerMin:             perMinute(t.cs, minutes),
+			GoldPerMin:           perMinute(t.gold, minutes),
+			DamageShare:          ratio(t.damage, match.damage),
+			DamageTakenShare:     ratio(t.damageTaken, match.damageTaken),
+			VisionPerMin:         perMinute(t.vision, minutes),
+			ObjectiveDamageShare: ratio(t.objective, match.objective),
+			FirstBlood:           team.FirstBlood,
+		})
+	}
+	return a
+}
+
+// Participant returns the analytics of participantID, nil when it did not play the match
+func (a *MatchAnalytics) Participant(participantID int) *ParticipantAnalytics {
+	for i := range a.Participants {
+		if a.Participants[i].ParticipantID == participantID {
+			return &a.Participants[i]
+		}
+	}
+	return nil
+}
+
+// Team returns the analytics of teamID, nil when it did not play the match
+func (a *MatchAnalytics) Team(teamID int) *TeamAnalytics {
+	for i := range a.Teams {
+		if a.Teams[i].TeamID == teamID {
+			return &a.Teams[i]
+		}
+	}
+	return nil
+}
+
+// FirstBloodInvolved reports whether the participant got or assisted first blood
+func (p *ParticipantAnalytics) FirstBloodInvolved() bool {
+	return p.FirstBloodKill || p.FirstBloodAssist
+}
+
+// KDA returns (kills + assists) / deaths, deathless games divide by one
+func KDA(kills, deaths, assists int) float64 {
+	if deaths == 0 {
+		deaths = 1
+	}
+	return float64(kills+assists) / float64(deaths)
+}
+
+func ratio(part, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(part) / float64(total)
+}
+
+func perMinute(n int, minutes float64) float64 {
+	if minutes <= 0 {
+		return 0
+	}
+	return float64(n) / minutes
+}
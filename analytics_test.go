@@ -0,0 +1,92 @@
+package lol
+
+import (
+	"log"
+	"math"
+	"net/http"
+	"testing"
+
+	"github.com/dnaeon/go-vcr/recorder"
+)
+
+func TestMatchAnalytics(t *testing.T) {
+	rec, err := recorder.New("cassettes/lol/match-v4/matches")
+	if err != nil {
+		log.Fatal(err)
+	} else {
+		rec.SetMatcher(matchWithoutToken)
+		httpClient = &http.Client{Transport: rec}
+	}
+	rec.AddFilter(removeToken)
+	defer rec.Stop()
+	cli, err := NewClient(testToken, WithHTTPClient(httpClient))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	dto, resp, err := cli.Matches(matchID)
+	if resp.StatusCode != 200 {
+		t.Errorf("\nExpected: 200 status code\nActual: %d status code", resp.StatusCode)
+		return
+	}
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	a := dto.Analytics()
+	if a.Remake {
+		t.Error("\nExpected: not a remake\nActual: remake")
+		return
+	}
+	p := a.Participant(5)
+	if p == nil || !p.FirstBloodInvolved() {
+		t.Error("\nExpected: participant 5 got first blood\nActual: no first blood")
+		return
+	}
+	tests := []struct {
+		expected, actual float64
+	}{
+		{4, p.KDA},
+		{28.0 / 55, p.KillParticipation},
+		{176 / (1813.0 / 60), p.CSPerMin},
+		{30130.0 / 117570, p.DamageShare},
+		{55, float64(a.Team(100).Kills)},
+		{117570.0 / (117570 + 108496), a.Team(100).DamageShare},
+	}
+	for _, test := range tests {
+		if math.Abs(test.expected-test.actual) > 1e-9 {
+			t.Errorf("\nExpected: %f\nActual: %f\n", test.expected, test.actual)
+			return
+		}
+	}
+	if !a.Team(100).Win || !a.Team(100).FirstBlood || a.Team(200).Win {
+		t.Error("\nExpected: team 100 won with first blood\nActual: otherwise")
+	}
+}
+
+func TestMatchAnalyticsRemake(t *testing.T) {
+	dto := &MatchDTO{
+		GameDuration: 200,
+		Teams:        []TeamStatsDTO{{TeamID: 100}, {TeamID: 200}},
+		Participants: []ParticipantDTO{
+			{ParticipantID: 1, TeamID: 100, Stats: ParticipantStatsDTO{TotalMinionsKilled: 10}},
+			{ParticipantID: 6, TeamID: 200},
+		},
+	}
+	a := dto.Analytics()
+	if !a.Remake {
+		t.Error("\nExpected: remake\nActual: not a remake")
+		return
+	}
+	if expected, actual := 3.0, a.Participant(1).CSPerMin; expected != actual {
+		t.Errorf("\nExpected: %f\nActual: %f\n", expected, actual)
+		return
+	}
+	if expected, actual := 0.0, a.Participant(6).KillParticipation; expected != actual {
+		t.Errorf("\nExpected: %f\nActual: %f\n", expected, actual)
+	}
+	if a := (&MatchDTO{}).Analytics(); a.Teams == nil || len(a.Participants) != 0 {
+		t.Error("\nExpected: empty analytics\nActual: otherwise")
+	}
+}
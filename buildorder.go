@@ -0,0 +1,277 @@
+package lol
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// StartingItemsWindow is how long after the game starts purchases count as starting items,
+	// minions reach the lanes shortly after
+	StartingItemsWindow = 65 * time.Second
+	// BuildPathLength is how many completed items, boots aside, make up a build path
+	BuildPathLength = 3
+)
+
+// skillSlotKeys maps SkillLevelUp.SkillSlot to the ability key
+var skillSlotKeys = map[int]string{1: "Q", 2: "W", 3: "E", 4: "R"}
+
+// skillMaxRank is the rank basic abilities max out at
+const skillMaxRank = 5
+
+// ItemPurchase is an item bought or sold at Timestamp milliseconds since the game started
+type ItemPurchase struct {
+	ItemID    int
+	Timestamp int64
+}
+
+// Build is the item build and skill order of a participant, rebuilt from timeline events
+// with undone purchases and sales removed
+type Build struct {
+	ParticipantID int
+	// Purchases holds every item bought in order, consumables and trinkets included
+	Purchases []ItemPurchase
+	Sales     []ItemPurchase
+	// StartingItems are the purchases made within StartingItemsWindow
+	StartingItems []ItemPurchase
+	// CompletedItems are the purchases that build into nothing else, boots included
+	CompletedItems []ItemPurchase
+	// Components are the purchases that build into other items
+	Components []ItemPurchase
+	// Boots is the first pair of finished boots, nil when none were bought
+	Boots *ItemPurchase
+	// SkillOrder holds the skill slot leveled at each level up, 1 to 4 for Q, W, E and R.
+	// Evolutions are left out.
+	SkillOrder []int
+}
+
+// BuildPath is a sequence of completed items of a champion and how often it was built
+type BuildPath struct {
+	ChampionID int
+	Items      []int
+	Count      int
+	Wins       int
+}
+
+// BuildPaths aggregates the build paths of champions across matches
+type BuildPaths struct {
+	data  *StaticData
+	paths map[int]map[string]*BuildPath
+}
+
+// Builds rebuilds the build of every participant in the timeline, keyed by participant ID.
+// data tells completed items from components.
+func (t *MatchTimelineDTO) Builds(data *StaticData) map[int]*Build {
+	builds := map[int]*Build{}
+	build := func(participantID int) *Build {
+		b, ok := builds[participantID]
+		if !ok {
+			b = &Build{ParticipantID: participantID}
+			builds[participantID] = b
+		}
+		return b
+	}
+	for _, event := range t.Events() {
+		switch e := event.(type) {
+		case ItemPurchased:
+			b := build(e.ParticipantID)
+			b.Purchases = append(b.Purchases, ItemPurchase{e.ItemID, e.Timestamp})
+		case ItemSold:
+			b := build(e.ParticipantID)
+			b.Sales = append(b.Sales, ItemPurchase{e.ItemID, e.Timestamp})
+		case ItemUndo:
+			b := build(e.ParticipantID)
+			if e.BeforeID != 0 {
+				b.Purchases = removeLastItem(b.Purchases, e.BeforeID)
+			} else {
+				b.Sales = removeLastItem(b.Sales, e.AfterID)
+			}
+		case SkillLevelUp:
+			// evolutions spend evolution points, not skill points
+			if e.LevelUpType != LevelUpTypeNormal {
+				continue
+			}
+			b := build(e.ParticipantID)
+			b.SkillOrder = append(b.SkillOrder, e.SkillSlot)
+		}
+	}
+	for _, b := range builds {
+		b.classify(data)
+	}
+	return builds
+}
+
+// Build rebuilds the build of participantID, see Builds
+func (t *MatchTimelineDTO) Build(data *StaticData, participantID int) *Build {
+	if b, ok := t.Builds(data)[participantID]; ok {
+		return b
+	}
+	return &Build{ParticipantID: participantID}
+}
+
+func (b *Build) classify(data *StaticData) {
+	window := int64(StartingItemsWindow / time.Millisecond)
+	for _, p := range b.Purchases {
+		if p.Timestamp < window {
+			b.StartingItems = append(b.StartingItems, p)
+		}
+		item := data.Item(int64(p.ItemID))
+		if item == nil {
+			continue
+		}
+		switch {
+		case len(item.Into) > 0:
+			b.Components = append(b.Components, p)
+		case len(item.From) > 0:
+			b.CompletedItems = append(b.CompletedItems, p)
+			if b.Boots == nil && isBoots(item) {
+				boots := p
+				b.Boots = &boots
+			}
+		}
+	}
+}
+
+// SkillMaxOrder returns the order the basic abilities were maxed in, e.g. Q>E>W. Abilities that
+// were not maxed follow by points spent, ties go to the ability leveled first.
+func (b *Build) SkillMaxOrder() string {
+	type skill struct {
+		slot, points, maxedAt, firstAt int
+	}
+	skills := make([]*skill, 0, 3)
+	for slot := 1; slot <= 3; slot++ {
+		skills = append(skills, &skill{slot: slot, maxedAt: len(b.SkillOrder), firstAt: len(b.SkillOrder)})
+	}
+	for level, slot := range b.SkillOrder {
+		if slot < 1 || slot > 3 {
+			continue
+		}
+		s := skills[slot-1]
+		s.points++
+		if s.points == 1 {
+			s.firstAt = level
+		}
+		if s.points == skillMaxRank {
+			s.maxedAt = level
+		}
+	}
+	sort.SliceStable(skills, func(i, j int) bool {
+		a, b := skills[i], skills[j]
+		switch {
+		case a.maxedAt != b.maxedAt:
+			return a.maxedAt < b.maxedAt
+		case a.points != b.points:
+			return a.points > b.points
+		default:
+			return a.firstAt < b.firstAt
+		}
+	})
+	keys := make([]string, 0, len(skills))
+	for _, s := range skills {
+		keys = append(keys, skillSlotKeys[s.slot])
+	}
+	return strings.Join(keys, ">")
+}
+
+// Path returns the first BuildPathLength completed items other than boots, fewer when the
+// game ended before they were finished
+func (b *Build) Path() []int {
+	var path []int
+	for _, p := range b.CompletedItems {
+		if b.Boots != nil && p.ItemID == b.Boots.ItemID {
+			continue
+		}
+		path = append(path, p.ItemID)
+		if len(path) == BuildPathLength {
+			break
+		}
+	}
+	return path
+}
+
+// NewBuildPaths returns an empty aggregate, data tells completed items from components
+func NewBuildPaths(data *StaticData) *BuildPaths {
+	return &BuildPaths{data: data, paths: map[int]map[string]*BuildPath{}}
+}
+
+// Add counts the build path of every participant of match that finished BuildPathLength items
+func (bp *BuildPaths) Add(match *MatchDTO, timeline *MatchTimelineDTO) {
+	builds := timeline.Builds(bp.data)
+	for _, p := range match.Participants {
+		b, ok := builds[p.ParticipantID]
+		if !ok {
+			continue
+		}
+		items := b.Path()
+		if len(items) < BuildPathLength {
+			continue
+		}
+		key := buildPathKey(items)
+		champion, ok := bp.paths[p.ChampionID]
+		if !ok {
+			champion = map[string]*BuildPath{}
+			bp.paths[p.ChampionID] = champion
+		}
+		path, ok := champion[key]
+		if !ok {
+			path = &BuildPath{ChampionID: p.ChampionID, Items: items}
+			champion[key] = path
+		}
+		path.Count++
+		if p.Stats.Win {
+			path.Wins++
+		}
+	}
+}
+
+// Common returns the n most built paths of championID, most built first
+func (bp *BuildPaths) Common(championID, n int) []BuildPath {
+	var paths []BuildPath
+	for _, p := range bp.paths[championID] {
+		paths = append(paths, *p)
+	}
+	sort.Slice(paths, func(i, j int) bool {
+		if paths[i].Count != paths[j].Count {
+			return paths[i].Count > paths[j].Count
+		}
+		return buildPathKey(paths[i].Items) < buildPathKey(paths[j].Items)
+	})
+	if n >= 0 && len(paths) > n {
+		paths = paths[:n]
+	}
+	return paths
+}
+
+// WinRate returns the fraction of games won with the path
+func (p BuildPath) WinRate() float64 {
+	return ratio(p.Wins, p.Count)
+}
+
+func buildPathKey(items []int) string {
+	ids := make([]string, len(items))
+	for i, id := range items {
+		ids[i] = strconv.Itoa(id)
+	}
+	return strings.Join(ids, ",")
+}
+
+func isBoots(item *Item) bool {
+	for _, tag := range item.Tags {
+		if tag == "Boots" {
+			return true
+		}
+	}
+	return false
+}
+
+// removeLastItem drops the latest entry of itemID, undo reverts the most recent transaction
+func removeLastItem(items []ItemPurchase, itemID int) []ItemPurchase {
+	for i := len(items) - 1; i >= 0; i-- {
+		if items[i].ItemID == itemID {
+			return append(items[:i], items[i+1:]...)
+		}
+	}
+	return items
+}
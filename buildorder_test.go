@@ -0,0 +1,175 @@
+package lol
+
+import (
+	"log"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/dnaeon/go-vcr/recorder"
+)
+
+func TestTimelineBuilds(t *testing.T) {
+	rec, err := recorder.New("cassettes/lol/match-v4/timelines")
+	if err != nil {
+		log.Fatal(err)
+	} else {
+		rec.SetMatcher(matchWithoutToken)
+		httpClient = &http.Client{Transport: rec}
+	}
+	rec.AddFilter(removeToken)
+	defer rec.Stop()
+	cli, err := NewClient(testToken, WithHTTPClient(httpClient))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	tl, resp, err := cli.Timelines(matchID)
+	if resp.StatusCode != 200 {
+		t.Errorf("\nExpected: 200 status code\nActual: %d status code", resp.StatusCode)
+		return
+	}
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	data, err := LoadStaticData(newBundleDataDragon(t))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	builds := tl.Builds(data)
+	if expected, actual := 10, len(builds); expected != actual {
+		t.Errorf("\nExpected: %d\nActual: %d\n", expected, actual)
+		return
+	}
+
+	bard := builds[1]
+	expectedStart := []ItemPurchase{{3303, 12322}, {2003, 13445}, {2003, 13709}, {3340, 14006}}
+	if !reflect.DeepEqual(expectedStart, bard.StartingItems) {
+		t.Errorf("\nExpected: %v\nActual: %v\n", expectedStart, bard.StartingItems)
+		return
+	}
+	if expected, actual := []int{3092, 3905, 3157}, bard.Path(); !reflect.DeepEqual(expected, actual) {
+		t.Errorf("\nExpected: %v\nActual: %v\n", expected, actual)
+		return
+	}
+	if expected := (ItemPurchase{3117, 586105}); bard.Boots == nil || expected != *bard.Boots {
+		t.Errorf("\nExpected: %v\nActual: %v\n", expected, bard.Boots)
+		return
+	}
+
+	// participant 3 joined late and undid a Doran's Ring and two potions on the first back
+	top := builds[3]
+	if len(top.StartingItems) != 0 || top.Purchases[0].ItemID != 1052 {
+		t.Errorf("\nExpected: Amplifying Tome as first purchase\nActual: %v\n", top.Purchases)
+		return
+	}
+	if expected, actual := []int{3152, 3100}, top.Path(); !reflect.DeepEqual(expected, actual) {
+		t.Errorf("\nExpected: %v\nActual: %v\n", expected, actual)
+		return
+	}
+
+	tests := []struct {
+		participantID int
+		expected      string
+	}{
+		{1, "Q>W>E"},
+		{3, "Q>E>W"},
+		{5, "W>Q>E"},
+	}
+	for _, test := range tests {
+		if actual := builds[test.participantID].SkillMaxOrder(); test.expected != actual {
+			t.Errorf("\nExpected: %s\nActual: %s\n", test.expected, actual)
+			return
+		}
+	}
+}
+
+func TestBuildPaths(t *testing.T) {
+	rec, err := recorder.New("cassettes/lol/match-v4/matches")
+	if err != nil {
+		log.Fatal(err)
+	} else {
+		rec.SetMatcher(matchWithoutToken)
+		httpClient = &http.Client{Transport: rec}
+	}
+	rec.AddFilter(removeToken)
+	defer rec.Stop()
+	cli, err := NewClient(testToken, WithHTTPClient(httpClient))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	match, resp, err := cli.Matches(matchID)
+	if resp.StatusCode != 200 {
+		t.Errorf("\nExpected: 200 status code\nActual: %d status code", resp.StatusCode)
+		return
+	}
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	timelineRec, err := recorder.New("cassettes/lol/match-v4/timelines")
+	if err != nil {
+		log.Fatal(err)
+	}
+	timelineRec.SetMatcher(matchWithoutToken)
+	timelineRec.AddFilter(removeToken)
+	defer timelineRec.Stop()
+	cli, err = NewClient(testToken, WithHTTPClient(&http.Client{Transport: timelineRec}))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	tl, _, err := cli.Timelines(matchID)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	data, err := LoadStaticData(newBundleDataDragon(t))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	paths := NewBuildPaths(data)
+	paths.Add(match, tl)
+	paths.Add(match, tl)
+	common := paths.Common(432, 5)
+	if len(common) != 1 {
+		t.Errorf("\nExpected: 1 path\nActual: %d paths\n", len(common))
+		return
+	}
+	expected := BuildPath{ChampionID: 432, Items: []int{3092, 3905, 3157}, Count: 2, Wins: 2}
+	if !reflect.DeepEqual(expected, common[0]) || common[0].WinRate() != 1 {
+		t.Errorf("\nExpected: %v\nActual: %v\n", expected, common[0])
+	}
+}
+
+func TestBuildSkillOrderSkipsEvolutions(t *testing.T) {
+	levelUp := func(ts int64, slot int, levelUpType string) MatchEventDTO {
+		return MatchEventDTO{Type: EventTypeSkillLevelUp, Timestamp: ts, ParticipantID: 1, SkillSlot: slot, LevelUpType: levelUpType}
+	}
+	tl := &MatchTimelineDTO{Frames: []MatchFrameDTO{{
+		Events: []MatchEventDTO{
+			levelUp(1000, 1, LevelUpTypeNormal),
+			levelUp(90000, 3, LevelUpTypeNormal),
+			levelUp(150000, 2, LevelUpTypeNormal),
+			levelUp(200000, 1, LevelUpTypeNormal),
+			levelUp(300000, 1, LevelUpTypeNormal),
+			levelUp(400000, 4, LevelUpTypeNormal),
+			levelUp(400500, 1, LevelUpTypeEvolve),
+			levelUp(500000, 1, LevelUpTypeNormal),
+		},
+	}}}
+
+	b := tl.Build(nil, 1)
+	if expected, actual := []int{1, 3, 2, 1, 1, 4, 1}, b.SkillOrder; !reflect.DeepEqual(expected, actual) {
+		t.Errorf("\nExpected: %v\nActual: %v\n", expected, actual)
+		return
+	}
+}
@@ -0,0 +1,151 @@
+package lol
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+)
+
+const maxCallbackBodyBytes = 1 << 20
+
+// GameResult is the payload Riot POSTs to a provider callback URL when a tournament game ends
+type GameResult struct {
+	StartTime   int64                `json:"startTime"`
+	ShortCode   string               `json:"shortCode"`
+	MetaData    string               `json:"metaData"`
+	GameID      int64                `json:"gameId"`
+	GameName    string               `json:"gameName"`
+	GameType    string               `json:"gameType"`
+	GameMap     int                  `json:"gameMap"`
+	GameMode    string               `json:"gameMode"`
+	Region      string               `json:"region"`
+	WinningTeam []GameResultSummoner `json:"winningTeam"`
+	LosingTeam  []GameResultSummoner `json:"losingTeam"`
+	// Match is only set when the handler was created with WithCallbackMatch
+	Match *MatchDTO `json:"-"`
+}
+
+type GameResultSummoner struct {
+	SummonerName string `json:"summonerName"`
+	SummonerID   string `json:"summonerId"`
+}
+
+// UnmarshalJSON accepts both the encrypted string and the legacy numeric summonerId
+func (s *GameResultSummoner) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		SummonerName string          `json:"summonerName"`
+		SummonerID   json.RawMessage `json:"summonerId"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	s.SummonerName = raw.SummonerName
+	s.SummonerID = ""
+	if len(raw.SummonerID) == 0 || string(raw.SummonerID) == "null" {
+		return nil
+	}
+	if raw.SummonerID[0] == '"' {
+		return json.Unmarshal(raw.SummonerID, &s.SummonerID)
+	}
+	var id json.Number
+	if err := json.Unmarshal(raw.SummonerID, &id); err != nil {
+		return err
+	}
+	s.SummonerID = id.String()
+	return nil
+}
+
+// Validate reports the first required field missing from the game result
+func (g *GameResult) Validate() error {
+	switch {
+	case g.ShortCode == "":
+		return errors.New("lol: game result is missing shortCode")
+	case g.GameID == 0:
+		return errors.New("lol: game result is missing gameId")
+	case g.Region == "":
+		return errors.New("lol: game result is missing region")
+	case len(g.WinningTeam) == 0 || len(g.LosingTeam) == 0:
+		return errors.New("lol: game result is missing winningTeam or losingTeam")
+	}
+	return nil
+}
+
+// CallbackOption is a func that operates on *CallbackHandler
+type CallbackOption func(*CallbackHandler) error
+
+// CallbackHandler is an http.Handler for tournament game result callbacks.
+// It responds 405 to anything but POST, 400 to payloads that fail to decode or validate,
+// 502 when the match lookup fails and 500 when the handle func returns an error.
+type CallbackHandler struct {
+	handle func(*GameResult) error
+	lol    *LOL
+}
+
+// NewCallbackHandler returns a CallbackHandler passing every valid game result to handle
+func NewCallbackHandler(handle func(*GameResult) error, options ...CallbackOption) (*CallbackHandler, error) {
+	if handle == nil {
+		return nil, errors.New("lol: callback handle func is nil")
+	}
+	h := &CallbackHandler{handle: handle}
+	for _, option := range options {
+		if err := option(h); err != nil {
+			return nil, err
+		}
+	}
+	return h, nil
+}
+
+// WithCallbackMatch fetch the full MatchDTO with MatchByTournamentCode before calling the handle func.
+// lol must point at the platform the tournament games are played on.
+func WithCallbackMatch(lol *LOL) CallbackOption {
+	return func(h *CallbackHandler) error {
+		if lol == nil {
+			return errors.New("lol: callback match lookup needs a LOL")
+		}
+		h.lol = lol
+		return nil
+	}
+}
+
+// ServeHTTP decodes and validates the game result then hands it to the handle func
+func (h *CallbackHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	result := new(GameResult)
+	body := io.LimitReader(r.Body, maxCallbackBodyBytes)
+	if err := json.NewDecoder(body).Decode(result); err != nil {
+		http.Error(w, fmt.Sprintf("invalid game result: %v", err), http.StatusBadRequest)
+		return
+	}
+	io.Copy(ioutil.Discard, body)
+	if err := result.Validate(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if h.lol != nil {
+		match, resp, err := h.lol.MatchByTournamentCode(strconv.FormatInt(result.GameID, 10), result.ShortCode)
+		if err == nil && resp.StatusCode != http.StatusOK {
+			err = fmt.Errorf("lol: match lookup failed: %s", resp.Status)
+		}
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadGateway)
+			return
+		}
+		result.Match = match
+	}
+
+	if err := h.handle(result); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,137 @@
+package lol
+
+import (
+	"errors"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/dnaeon/go-vcr/recorder"
+)
+
+var gameResultBody = `{
+	"startTime": 1574023272000,
+	"shortCode": "NA04373-a7c0ee6e-6d1f-4b1e-8e3e-0cd4a5a1d8d6",
+	"metaData": "match-1",
+	"gameId": 3198831326,
+	"gameName": "b5b1b4d0-4a6f-4a5e-8f3c-0b9c1c3e0a11",
+	"gameType": "Practice",
+	"gameMap": 11,
+	"gameMode": "CLASSIC",
+	"region": "NA1",
+	"winningTeam": [{"summonerName": "ilikeduck", "summonerId": "1NgBFb-1WXj-ku_Fym3BQF1FxXUz9xrvpuIPVnSdvo6KjHo"}],
+	"losingTeam": [{"summonerName": "bnage", "summonerId": 12345}]
+}`
+
+func TestCallbackHandler(t *testing.T) {
+	var result *GameResult
+	h, err := NewCallbackHandler(func(g *GameResult) error {
+		result = g
+		return nil
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/callback", strings.NewReader(gameResultBody)))
+	if w.Code != 200 {
+		t.Errorf("\nExpected: 200 status code\nActual: %d status code", w.Code)
+		return
+	}
+	expected := tournamentCode
+	actual := result.ShortCode
+	if expected != actual {
+		t.Errorf("\nExpected: %s\nActual: %s\n", expected, actual)
+		return
+	}
+	expected = "12345"
+	actual = result.LosingTeam[0].SummonerID
+	if expected != actual {
+		t.Errorf("\nExpected: %s\nActual: %s\n", expected, actual)
+		return
+	}
+	if result.Match != nil {
+		t.Errorf("\nExpected: nil match\nActual: %v\n", result.Match)
+		return
+	}
+}
+
+func TestCallbackHandlerWithMatch(t *testing.T) {
+	rec, err := recorder.New("cassettes/lol/match-v4/match-by-tournament-code")
+	if err != nil {
+		log.Fatal(err)
+	} else {
+		rec.SetMatcher(matchWithoutToken)
+		httpClient = &http.Client{Transport: rec}
+	}
+	rec.AddFilter(removeToken)
+	defer rec.Stop()
+	cli, err := NewClient(testToken, WithHTTPClient(httpClient))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	var result *GameResult
+	h, err := NewCallbackHandler(func(g *GameResult) error {
+		result = g
+		return nil
+	}, WithCallbackMatch(cli.LOL))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/callback", strings.NewReader(gameResultBody)))
+	if w.Code != 200 {
+		t.Errorf("\nExpected: 200 status code\nActual: %d status code", w.Code)
+		return
+	}
+	expected := result.GameID
+	actual := result.Match.GameID
+	if expected != actual {
+		t.Errorf("\nExpected: %d\nActual: %d\n", expected, actual)
+		return
+	}
+}
+
+func TestCallbackHandlerRejects(t *testing.T) {
+	called := false
+	h, err := NewCallbackHandler(func(g *GameResult) error {
+		called = true
+		return errors.New("storage is down")
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	tests := []struct {
+		method, body string
+		code         int
+	}{
+		{http.MethodGet, "", 405},
+		{http.MethodPost, "not json", 400},
+		{http.MethodPost, `{"gameId": 3198831326, "region": "NA1"}`, 400},
+		{http.MethodPost, gameResultBody, 500},
+	}
+	for _, test := range tests {
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, httptest.NewRequest(test.method, "/callback", strings.NewReader(test.body)))
+		expected := test.code
+		actual := w.Code
+		if expected != actual {
+			t.Errorf("\nExpected: %d status code\nActual: %d status code", expected, actual)
+			return
+		}
+	}
+	if !called {
+		t.Errorf("\nExpected: handle func called\nActual: not called\n")
+		return
+	}
+}
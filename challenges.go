@@ -0,0 +1,227 @@
+package lol
+
+import (
+	"net/http"
+	"sort"
+)
+
+// ChallengeLevels are the challenge levels from lowest to highest
+var ChallengeLevels = []string{
+	"NONE",
+	"IRON",
+	"BRONZE",
+	"SILVER",
+	"GOLD",
+	"PLATINUM",
+	"DIAMOND",
+	"MASTER",
+	"GRANDMASTER",
+	"CHALLENGER",
+}
+
+type ChallengeConfigInfoDTO struct {
+	ID             int64                        `json:"id"`
+	LocalizedNames map[string]map[string]string `json:"localizedNames"`
+	State          string                       `json:"state"`
+	Tracking       string                       `json:"tracking"`
+	StartTimestamp int64                        `json:"startTimestamp"`
+	EndTimestamp   int64                        `json:"endTimestamp"`
+	Leaderboard    bool                         `json:"leaderboard"`
+	Thresholds     map[string]float64           `json:"thresholds"`
+}
+
+type ApexPlayerInfoDTO struct {
+	Puuid    string  `json:"puuid"`
+	Value    float64 `json:"value"`
+	Position int     `json:"position"`
+}
+
+type ChallengeLeaderboardsParams struct {
+	Limit int `url:"limit,omitempty"`
+}
+
+type PlayerInfoDTO struct {
+	Challenges     []ChallengeInfo            `json:"challenges"`
+	Preferences    PlayerClientPreferences    `json:"preferences"`
+	TotalPoints    ChallengePoints            `json:"totalPoints"`
+	CategoryPoints map[string]ChallengePoints `json:"categoryPoints"`
+}
+
+type ChallengeInfo struct {
+	ChallengeID    int64   `json:"challengeId"`
+	Percentile     float64 `json:"percentile"`
+	Level          string  `json:"level"`
+	Value          float64 `json:"value"`
+	AchievedTime   int64   `json:"achievedTime"`
+	Position       int     `json:"position"`
+	PlayersInLevel int     `json:"playersInLevel"`
+}
+
+type ChallengePoints struct {
+	Level      string  `json:"level"`
+	Current    int     `json:"current"`
+	Max        int     `json:"max"`
+	Percentile float64 `json:"percentile"`
+}
+
+type PlayerClientPreferences struct {
+	BannerAccent             string  `json:"bannerAccent"`
+	Title                    string  `json:"title"`
+	ChallengeIDs             []int64 `json:"challengeIds"`
+	CrestBorder              string  `json:"crestBorder"`
+	PrestigeCrestBorderLevel int     `json:"prestigeCrestBorderLevel"`
+}
+
+// PlayerChallenge is a player's progress in a challenge joined with the challenge config
+type PlayerChallenge struct {
+	ChallengeInfo
+	Name             string
+	Description      string
+	ShortDescription string
+	State            string
+	Thresholds       map[string]float64
+	// NextLevel is the next level with a threshold above the player's level, empty at the top
+	NextLevel     string
+	NextThreshold float64
+}
+
+// ChallengesConfig GET /lol/challenges/v1/challenges/config
+func (l *LOL) ChallengesConfig() ([]ChallengeConfigInfoDTO, *http.Response, error) {
+	dtos := new([]ChallengeConfigInfoDTO)
+	var reqErr error
+	resp, err := l.sling.New().Get("challenges/v1/challenges/config").Receive(dtos, reqErr)
+	if err != nil {
+		return nil, resp, err
+	}
+	return *dtos, resp, reqErr
+}
+
+// ChallengesPercentiles GET /lol/challenges/v1/challenges/percentiles
+func (l *LOL) ChallengesPercentiles() (map[int64]map[string]float64, *http.Response, error) {
+	percentiles := new(map[int64]map[string]float64)
+	var reqErr error
+	resp, err := l.sling.New().Get("challenges/v1/challenges/percentiles").Receive(percentiles, reqErr)
+	if err != nil {
+		return nil, resp, err
+	}
+	return *percentiles, resp, reqErr
+}
+
+// ChallengeConfig GET /lol/challenges/v1/challenges/{challengeID}/config
+func (l *LOL) ChallengeConfig(challengeID string) (*ChallengeConfigInfoDTO, *http.Response, error) {
+	dto := new(ChallengeConfigInfoDTO)
+	var reqErr error
+	resp, err := l.sling.New().Get("challenges/v1/challenges/"+challengeID+"/config").Receive(dto, reqErr)
+	if err != nil {
+		return nil, resp, err
+	}
+	return dto, resp, reqErr
+}
+
+// ChallengeLeaderboards GET /lol/challenges/v1/challenges/{challengeID}/leaderboards/by-level/{level}
+func (l *LOL) ChallengeLeaderboards(challengeID, level string, params *ChallengeLeaderboardsParams) ([]ApexPlayerInfoDTO, *http.Response, error) {
+	dtos := new([]ApexPlayerInfoDTO)
+	var reqErr error
+	endpoint := "challenges/v1/challenges/" + challengeID + "/leaderboards/by-level/" + level
+	resp, err := l.sling.New().Get(endpoint).QueryStruct(params).Receive(dtos, reqErr)
+	if err != nil {
+		return nil, resp, err
+	}
+	return *dtos, resp, reqErr
+}
+
+// ChallengePercentiles GET /lol/challenges/v1/challenges/{challengeID}/percentiles
+func (l *LOL) ChallengePercentiles(challengeID string) (map[string]float64, *http.Response, error) {
+	percentiles := new(map[string]float64)
+	var reqErr error
+	resp, err := l.sling.New().Get("challenges/v1/challenges/"+challengeID+"/percentiles").Receive(percentiles, reqErr)
+	if err != nil {
+		return nil, resp, err
+	}
+	return *percentiles, resp, reqErr
+}
+
+// ChallengesPlayerData GET /lol/challenges/v1/player-data/{puuid}
+func (l *LOL) ChallengesPlayerData(puuid string) (*PlayerInfoDTO, *http.Response, error) {
+	dto := new(PlayerInfoDTO)
+	var reqErr error
+	resp, err := l.sling.New().Get("challenges/v1/player-data/"+puuid).Receive(dto, reqErr)
+	if err != nil {
+		return nil, resp, err
+	}
+	return dto, resp, reqErr
+}
+
+// PlayerChallenges fetches the challenge config and the player data for puuid and joins them
+// with JoinChallenges. The response of the last call made is returned.
+func (l *LOL) PlayerChallenges(puuid, locale string) ([]PlayerChallenge, *http.Response, error) {
+	configs, resp, err := l.ChallengesConfig()
+	if err != nil {
+		return nil, resp, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, resp, nil
+	}
+	player, resp, err := l.ChallengesPlayerData(puuid)
+	if err != nil {
+		return nil, resp, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, resp, nil
+	}
+	return JoinChallenges(player, configs, locale), resp, nil
+}
+
+// JoinChallenges joins each of the player's challenges with its config. Names and descriptions
+// use the locale that best matches locale, see BestLocale. Challenges without a config keep only the player data.
+func JoinChallenges(player *PlayerInfoDTO, configs []ChallengeConfigInfoDTO, locale string) []PlayerChallenge {
+	byID := make(map[int64]ChallengeConfigInfoDTO, len(configs))
+	for _, config := range configs {
+		byID[config.ID] = config
+	}
+
+	challenges := make([]PlayerChallenge, 0, len(player.Challenges))
+	for _, info := range player.Challenges {
+		challenge := PlayerChallenge{ChallengeInfo: info}
+		if config, ok := byID[info.ChallengeID]; ok {
+			names := localizedNames(config.LocalizedNames, locale)
+			challenge.Name = names["name"]
+			challenge.Description = names["description"]
+			challenge.ShortDescription = names["shortDescription"]
+			challenge.State = config.State
+			challenge.Thresholds = config.Thresholds
+			challenge.NextLevel, challenge.NextThreshold = nextChallengeLevel(info.Level, config.Thresholds)
+		}
+		challenges = append(challenges, challenge)
+	}
+	return challenges
+}
+
+// localizedNames returns the names of the locale that best matches locale, see BestLocale
+func localizedNames(names map[string]map[string]string, locale string) map[string]string {
+	locales := make([]string, 0, len(names))
+	for l := range names {
+		locales = append(locales, l)
+	}
+	// sorted so the same language fallback does not depend on map order
+	sort.Strings(locales)
+	if i := BestLocale(locales, locale); i >= 0 {
+		return names[locales[i]]
+	}
+	return nil
+}
+
+func nextChallengeLevel(level string, thresholds map[string]float64) (string, float64) {
+	passed := false
+	for _, l := range ChallengeLevels {
+		if passed {
+			if threshold, ok := thresholds[l]; ok {
+				return l, threshold
+			}
+		}
+		if l == level {
+			passed = true
+		}
+	}
+	return "", 0
+}
@@ -0,0 +1,299 @@
+package lol
+
+import (
+	"log"
+	"net/http"
+	"testing"
+
+	"github.com/dnaeon/go-vcr/recorder"
+)
+
+var (
+	challengeID = "101101"
+)
+
+func TestChallengesConfig(t *testing.T) {
+	rec, err := recorder.New("cassettes/lol/challenges-v1/challenges-config")
+	if err != nil {
+		log.Fatal(err)
+	} else {
+		rec.SetMatcher(matchWithoutToken)
+		httpClient = &http.Client{Transport: rec}
+	}
+	rec.AddFilter(removeToken)
+	defer rec.Stop()
+	cli, err := NewClient(testToken, WithHTTPClient(httpClient))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	dtos, resp, err := cli.ChallengesConfig()
+	if resp.StatusCode != 200 {
+		t.Errorf("\nExpected: 200 status code\nActual: %d status code", resp.StatusCode)
+		return
+	}
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	expected := "DPS Threat"
+	actual := dtos[0].LocalizedNames["en_US"]["name"]
+	if expected != actual {
+		t.Errorf("\nExpected: %s\nActual: %s\n", expected, actual)
+		return
+	}
+}
+
+func TestChallengesPercentiles(t *testing.T) {
+	rec, err := recorder.New("cassettes/lol/challenges-v1/challenges-percentiles")
+	if err != nil {
+		log.Fatal(err)
+	} else {
+		rec.SetMatcher(matchWithoutToken)
+		httpClient = &http.Client{Transport: rec}
+	}
+	rec.AddFilter(removeToken)
+	defer rec.Stop()
+	cli, err := NewClient(testToken, WithHTTPClient(httpClient))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	percentiles, resp, err := cli.ChallengesPercentiles()
+	if resp.StatusCode != 200 {
+		t.Errorf("\nExpected: 200 status code\nActual: %d status code", resp.StatusCode)
+		return
+	}
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	expected := 0.09
+	actual := percentiles[101101]["PLATINUM"]
+	if expected != actual {
+		t.Errorf("\nExpected: %v\nActual: %v\n", expected, actual)
+		return
+	}
+}
+
+func TestChallengeConfig(t *testing.T) {
+	rec, err := recorder.New("cassettes/lol/challenges-v1/challenge-config")
+	if err != nil {
+		log.Fatal(err)
+	} else {
+		rec.SetMatcher(matchWithoutToken)
+		httpClient = &http.Client{Transport: rec}
+	}
+	rec.AddFilter(removeToken)
+	defer rec.Stop()
+	cli, err := NewClient(testToken, WithHTTPClient(httpClient))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	dto, resp, err := cli.ChallengeConfig(challengeID)
+	if resp.StatusCode != 200 {
+		t.Errorf("\nExpected: 200 status code\nActual: %d status code", resp.StatusCode)
+		return
+	}
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	expected := 1000.0
+	actual := dto.Thresholds["CHALLENGER"]
+	if expected != actual {
+		t.Errorf("\nExpected: %v\nActual: %v\n", expected, actual)
+		return
+	}
+}
+
+func TestChallengeLeaderboards(t *testing.T) {
+	rec, err := recorder.New("cassettes/lol/challenges-v1/challenge-leaderboards")
+	if err != nil {
+		log.Fatal(err)
+	} else {
+		rec.SetMatcher(matchWithoutToken)
+		httpClient = &http.Client{Transport: rec}
+	}
+	rec.AddFilter(removeToken)
+	defer rec.Stop()
+	cli, err := NewClient(testToken, WithHTTPClient(httpClient))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	dtos, resp, err := cli.ChallengeLeaderboards(challengeID, "CHALLENGER", &ChallengeLeaderboardsParams{Limit: 2})
+	if resp.StatusCode != 200 {
+		t.Errorf("\nExpected: 200 status code\nActual: %d status code", resp.StatusCode)
+		return
+	}
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	expected := encryptedPUUID
+	actual := dtos[0].Puuid
+	if expected != actual {
+		t.Errorf("\nExpected: %s\nActual: %s\n", expected, actual)
+		return
+	}
+}
+
+func TestChallengePercentiles(t *testing.T) {
+	rec, err := recorder.New("cassettes/lol/challenges-v1/challenge-percentiles")
+	if err != nil {
+		log.Fatal(err)
+	} else {
+		rec.SetMatcher(matchWithoutToken)
+		httpClient = &http.Client{Transport: rec}
+	}
+	rec.AddFilter(removeToken)
+	defer rec.Stop()
+	cli, err := NewClient(testToken, WithHTTPClient(httpClient))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	percentiles, resp, err := cli.ChallengePercentiles(challengeID)
+	if resp.StatusCode != 200 {
+		t.Errorf("\nExpected: 200 status code\nActual: %d status code", resp.StatusCode)
+		return
+	}
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	expected := 0.22
+	actual := percentiles["GOLD"]
+	if expected != actual {
+		t.Errorf("\nExpected: %v\nActual: %v\n", expected, actual)
+		return
+	}
+}
+
+func TestChallengesPlayerData(t *testing.T) {
+	rec, err := recorder.New("cassettes/lol/challenges-v1/challenges-player-data")
+	if err != nil {
+		log.Fatal(err)
+	} else {
+		rec.SetMatcher(matchWithoutToken)
+		httpClient = &http.Client{Transport: rec}
+	}
+	rec.AddFilter(removeToken)
+	defer rec.Stop()
+	cli, err := NewClient(testToken, WithHTTPClient(httpClient))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	dto, resp, err := cli.ChallengesPlayerData(encryptedPUUID)
+	if resp.StatusCode != 200 {
+		t.Errorf("\nExpected: 200 status code\nActual: %d status code", resp.StatusCode)
+		return
+	}
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	expected := 3325
+	actual := dto.TotalPoints.Current
+	if expected != actual {
+		t.Errorf("\nExpected: %d\nActual: %d\n", expected, actual)
+		return
+	}
+}
+
+func TestPlayerChallenges(t *testing.T) {
+	rec, err := recorder.New("cassettes/lol/challenges-v1/player-challenges")
+	if err != nil {
+		log.Fatal(err)
+	} else {
+		rec.SetMatcher(matchWithoutToken)
+		httpClient = &http.Client{Transport: rec}
+	}
+	rec.AddFilter(removeToken)
+	defer rec.Stop()
+	cli, err := NewClient(testToken, WithHTTPClient(httpClient))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	challenges, resp, err := cli.PlayerChallenges(encryptedPUUID, "es_ES")
+	if resp.StatusCode != 200 {
+		t.Errorf("\nExpected: 200 status code\nActual: %d status code", resp.StatusCode)
+		return
+	}
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	expected := "Amenaza de DPS"
+	actual := challenges[0].Name
+	if expected != actual {
+		t.Errorf("\nExpected: %s\nActual: %s\n", expected, actual)
+		return
+	}
+	expected = "DIAMOND"
+	actual = challenges[0].NextLevel
+	if expected != actual {
+		t.Errorf("\nExpected: %s\nActual: %s\n", expected, actual)
+		return
+	}
+	// no es_ES names so en_US is used, and PLATINUM is skipped as it has no threshold
+	expected = "Five Stack"
+	actual = challenges[1].Name
+	if expected != actual {
+		t.Errorf("\nExpected: %s\nActual: %s\n", expected, actual)
+		return
+	}
+	expected = "MASTER"
+	actual = challenges[1].NextLevel
+	if expected != actual {
+		t.Errorf("\nExpected: %s\nActual: %s\n", expected, actual)
+		return
+	}
+	expected = ""
+	actual = challenges[2].Name
+	if expected != actual {
+		t.Errorf("\nExpected: %s\nActual: %s\n", expected, actual)
+		return
+	}
+}
+
+func TestJoinChallengesLocaleFallback(t *testing.T) {
+	player := &PlayerInfoDTO{Challenges: []ChallengeInfo{{ChallengeID: 101101, Level: "GOLD"}}}
+	configs := []ChallengeConfigInfoDTO{{
+		ID: 101101,
+		LocalizedNames: map[string]map[string]string{
+			"en_US": {"name": "DPS Threat"},
+			"es_ES": {"name": "Amenaza de DPS"},
+			"es_MX": {"name": "Amenaza DPS"},
+		},
+	}}
+
+	tests := []struct {
+		locale   string
+		expected string
+	}{
+		{"es_MX", "Amenaza DPS"},
+		{"es-mx", "Amenaza DPS"},
+		{"en_GB", "DPS Threat"},
+		{"es_AR", "Amenaza de DPS"},
+		{"ko_KR", "DPS Threat"},
+	}
+	for _, test := range tests {
+		actual := JoinChallenges(player, configs, test.locale)[0].Name
+		if test.expected != actual {
+			t.Errorf("\nExpected: %s\nActual: %s\n", test.expected, actual)
+			return
+		}
+	}
+}
@@ -0,0 +1,182 @@
+package lol
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+)
+
+// Clash team positions
+const (
+	ClashPositionUnselected = "UNSELECTED"
+	ClashPositionFill       = "FILL"
+	ClashPositionTop        = "TOP"
+	ClashPositionJungle     = "JUNGLE"
+	ClashPositionMiddle     = "MIDDLE"
+	ClashPositionBottom     = "BOTTOM"
+	ClashPositionUtility    = "UTILITY"
+)
+
+// Clash team roles
+const (
+	ClashRoleCaptain = "CAPTAIN"
+	ClashRoleMember  = "MEMBER"
+)
+
+type ClashPlayerDTO struct {
+	SummonerID string `json:"summonerId"`
+	TeamID     string `json:"teamId"`
+	Position   string `json:"position"`
+	Role       string `json:"role"`
+}
+
+type ClashTeamDTO struct {
+	ID           string           `json:"id"`
+	TournamentID int              `json:"tournamentId"`
+	Name         string           `json:"name"`
+	IconID       int              `json:"iconId"`
+	Tier         int              `json:"tier"`
+	Captain      string           `json:"captain"`
+	Abbreviation string           `json:"abbreviation"`
+	Players      []ClashPlayerDTO `json:"players"`
+}
+
+type ClashTournamentDTO struct {
+	ID               int                       `json:"id"`
+	ThemeID          int                       `json:"themeId"`
+	NameKey          string                    `json:"nameKey"`
+	NameKeySecondary string                    `json:"nameKeySecondary"`
+	Schedule         []ClashTournamentPhaseDTO `json:"schedule"`
+}
+
+type ClashTournamentPhaseDTO struct {
+	ID               int   `json:"id"`
+	RegistrationTime int64 `json:"registrationTime"`
+	StartTime        int64 `json:"startTime"`
+	Cancelled        bool  `json:"cancelled"`
+}
+
+// ClashScout is a Clash team with the ranked entries and champion masteries of every member
+type ClashScout struct {
+	Team    *ClashTeamDTO
+	Members []ClashMemberScout
+}
+
+type ClashMemberScout struct {
+	Player          ClashPlayerDTO
+	Entries         []LeagueEntryDTO
+	ChampionMastery []ChampionMasteryDTO
+}
+
+// ClashPlayersBySummoner GET /lol/clash/v1/players/by-summoner/{summonerID}
+func (l *LOL) ClashPlayersBySummoner(summonerID string) ([]ClashPlayerDTO, *http.Response, error) {
+	dtos := new([]ClashPlayerDTO)
+	var reqErr error
+	resp, err := l.sling.New().Get("clash/v1/players/by-summoner/"+summonerID).Receive(dtos, reqErr)
+	if err != nil {
+		return nil, resp, err
+	}
+	return *dtos, resp, reqErr
+}
+
+// ClashTeam GET /lol/clash/v1/teams/{teamID}
+func (l *LOL) ClashTeam(teamID string) (*ClashTeamDTO, *http.Response, error) {
+	dto := new(ClashTeamDTO)
+	var reqErr error
+	resp, err := l.sling.New().Get("clash/v1/teams/"+teamID).Receive(dto, reqErr)
+	if err != nil {
+		return nil, resp, err
+	}
+	return dto, resp, reqErr
+}
+
+// ClashTournaments GET /lol/clash/v1/tournaments
+func (l *LOL) ClashTournaments() ([]ClashTournamentDTO, *http.Response, error) {
+	dtos := new([]ClashTournamentDTO)
+	var reqErr error
+	resp, err := l.sling.New().Get("clash/v1/tournaments").Receive(dtos, reqErr)
+	if err != nil {
+		return nil, resp, err
+	}
+	return *dtos, resp, reqErr
+}
+
+// ClashTournamentByTeam GET /lol/clash/v1/tournaments/by-team/{teamID}
+func (l *LOL) ClashTournamentByTeam(teamID string) (*ClashTournamentDTO, *http.Response, error) {
+	dto := new(ClashTournamentDTO)
+	var reqErr error
+	resp, err := l.sling.New().Get("clash/v1/tournaments/by-team/"+teamID).Receive(dto, reqErr)
+	if err != nil {
+		return nil, resp, err
+	}
+	return dto, resp, reqErr
+}
+
+// ClashTournament GET /lol/clash/v1/tournaments/{tournamentID}
+func (l *LOL) ClashTournament(tournamentID string) (*ClashTournamentDTO, *http.Response, error) {
+	dto := new(ClashTournamentDTO)
+	var reqErr error
+	resp, err := l.sling.New().Get("clash/v1/tournaments/"+tournamentID).Receive(dto, reqErr)
+	if err != nil {
+		return nil, resp, err
+	}
+	return dto, resp, reqErr
+}
+
+// clashScoutConcurrency is how many member requests ScoutClashTeam has in flight at once
+const clashScoutConcurrency = 4
+
+// ScoutClashTeam looks up a Clash team then fetches EntriesBySummoner and AllChampionMastery
+// for every member, clashScoutConcurrency requests at a time. The team response is returned;
+// when the team lookup does not succeed the scout is nil. When a member lookup fails or does not
+// succeed the first such error is returned, naming the member.
+func (l *LOL) ScoutClashTeam(teamID string) (*ClashScout, *http.Response, error) {
+	team, resp, err := l.ClashTeam(teamID)
+	if err != nil {
+		return nil, resp, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, resp, nil
+	}
+
+	scout := &ClashScout{Team: team, Members: make([]ClashMemberScout, len(team.Players))}
+	errs := make([]error, 2*len(team.Players))
+	sem := make(chan struct{}, clashScoutConcurrency)
+	var wg sync.WaitGroup
+	scoutMember := func(i int, summonerID, endpoint string, lookup func() (*http.Response, error)) {
+		defer wg.Done()
+		sem <- struct{}{}
+		defer func() { <-sem }()
+		memberResp, err := lookup()
+		if err != nil {
+			errs[i] = fmt.Errorf("lol: scouting %s %s: %v", summonerID, endpoint, err)
+		} else if memberResp.StatusCode != http.StatusOK {
+			errs[i] = fmt.Errorf("lol: scouting %s %s failed: %s", summonerID, endpoint, memberResp.Status)
+		}
+	}
+	for i, player := range team.Players {
+		member, summonerID := &scout.Members[i], player.SummonerID
+		member.Player = player
+		wg.Add(2)
+		go scoutMember(2*i, summonerID, "league entries", func() (*http.Response, error) {
+			entries, memberResp, err := l.EntriesBySummoner(summonerID)
+			member.Entries = entries
+			return memberResp, err
+		})
+		go scoutMember(2*i+1, summonerID, "champion mastery", func() (*http.Response, error) {
+			masteries, memberResp, err := l.AllChampionMastery(summonerID)
+			if masteries != nil {
+				member.ChampionMastery = *masteries
+			}
+			return memberResp, err
+		})
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, resp, err
+		}
+	}
+	return scout, resp, nil
+}
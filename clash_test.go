@@ -0,0 +1,250 @@
+package lol
+
+import (
+	"log"
+	"net/http"
+	"testing"
+
+	"github.com/dnaeon/go-vcr/recorder"
+)
+
+var (
+	clashTeamID       = "2137081"
+	clashTournamentID = "2101"
+)
+
+func TestClashPlayersBySummoner(t *testing.T) {
+	rec, err := recorder.New("cassettes/lol/clash-v1/players-by-summoner")
+	if err != nil {
+		log.Fatal(err)
+	} else {
+		rec.SetMatcher(matchWithoutToken)
+		httpClient = &http.Client{Transport: rec}
+	}
+	rec.AddFilter(removeToken)
+	defer rec.Stop()
+	cli, err := NewClient(testToken, WithHTTPClient(httpClient))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	dtos, resp, err := cli.ClashPlayersBySummoner(encryptedSummonerID)
+	if resp.StatusCode != 200 {
+		t.Errorf("\nExpected: 200 status code\nActual: %d status code", resp.StatusCode)
+		return
+	}
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	expected := clashTeamID
+	actual := dtos[0].TeamID
+	if expected != actual {
+		t.Errorf("\nExpected: %s\nActual: %s\n", expected, actual)
+		return
+	}
+}
+
+func TestClashTeam(t *testing.T) {
+	rec, err := recorder.New("cassettes/lol/clash-v1/team")
+	if err != nil {
+		log.Fatal(err)
+	} else {
+		rec.SetMatcher(matchWithoutToken)
+		httpClient = &http.Client{Transport: rec}
+	}
+	rec.AddFilter(removeToken)
+	defer rec.Stop()
+	cli, err := NewClient(testToken, WithHTTPClient(httpClient))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	dto, resp, err := cli.ClashTeam(clashTeamID)
+	if resp.StatusCode != 200 {
+		t.Errorf("\nExpected: 200 status code\nActual: %d status code", resp.StatusCode)
+		return
+	}
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	expected := ClashRoleCaptain
+	actual := dto.Players[0].Role
+	if expected != actual {
+		t.Errorf("\nExpected: %s\nActual: %s\n", expected, actual)
+		return
+	}
+}
+
+func TestClashTournaments(t *testing.T) {
+	rec, err := recorder.New("cassettes/lol/clash-v1/tournaments")
+	if err != nil {
+		log.Fatal(err)
+	} else {
+		rec.SetMatcher(matchWithoutToken)
+		httpClient = &http.Client{Transport: rec}
+	}
+	rec.AddFilter(removeToken)
+	defer rec.Stop()
+	cli, err := NewClient(testToken, WithHTTPClient(httpClient))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	dtos, resp, err := cli.ClashTournaments()
+	if resp.StatusCode != 200 {
+		t.Errorf("\nExpected: 200 status code\nActual: %d status code", resp.StatusCode)
+		return
+	}
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	expected := "day_2"
+	actual := dtos[0].NameKeySecondary
+	if expected != actual {
+		t.Errorf("\nExpected: %s\nActual: %s\n", expected, actual)
+		return
+	}
+}
+
+func TestClashTournamentByTeam(t *testing.T) {
+	rec, err := recorder.New("cassettes/lol/clash-v1/tournament-by-team")
+	if err != nil {
+		log.Fatal(err)
+	} else {
+		rec.SetMatcher(matchWithoutToken)
+		httpClient = &http.Client{Transport: rec}
+	}
+	rec.AddFilter(removeToken)
+	defer rec.Stop()
+	cli, err := NewClient(testToken, WithHTTPClient(httpClient))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	dto, resp, err := cli.ClashTournamentByTeam(clashTeamID)
+	if resp.StatusCode != 200 {
+		t.Errorf("\nExpected: 200 status code\nActual: %d status code", resp.StatusCode)
+		return
+	}
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	expected := 2101
+	actual := dto.ID
+	if expected != actual {
+		t.Errorf("\nExpected: %d\nActual: %d\n", expected, actual)
+		return
+	}
+}
+
+func TestClashTournament(t *testing.T) {
+	rec, err := recorder.New("cassettes/lol/clash-v1/tournament")
+	if err != nil {
+		log.Fatal(err)
+	} else {
+		rec.SetMatcher(matchWithoutToken)
+		httpClient = &http.Client{Transport: rec}
+	}
+	rec.AddFilter(removeToken)
+	defer rec.Stop()
+	cli, err := NewClient(testToken, WithHTTPClient(httpClient))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	dto, resp, err := cli.ClashTournament(clashTournamentID)
+	if resp.StatusCode != 200 {
+		t.Errorf("\nExpected: 200 status code\nActual: %d status code", resp.StatusCode)
+		return
+	}
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	expected := int64(1574553600000)
+	actual := dto.Schedule[0].StartTime
+	if expected != actual {
+		t.Errorf("\nExpected: %d\nActual: %d\n", expected, actual)
+		return
+	}
+}
+
+func TestScoutClashTeam(t *testing.T) {
+	rec, err := recorder.New("cassettes/lol/clash-v1/scout-clash-team")
+	if err != nil {
+		log.Fatal(err)
+	} else {
+		rec.SetMatcher(matchWithoutToken)
+		httpClient = &http.Client{Transport: rec}
+	}
+	rec.AddFilter(removeToken)
+	defer rec.Stop()
+	cli, err := NewClient(testToken, WithHTTPClient(httpClient))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	scout, resp, err := cli.ScoutClashTeam(clashTeamID)
+	if resp.StatusCode != 200 {
+		t.Errorf("\nExpected: 200 status code\nActual: %d status code", resp.StatusCode)
+		return
+	}
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	expected := "DIAMOND"
+	actual := scout.Members[2].Entries[0].Tier
+	if expected != actual {
+		t.Errorf("\nExpected: %s\nActual: %s\n", expected, actual)
+		return
+	}
+	expectedMastery := 43
+	actualMastery := scout.Members[4].ChampionMastery[0].ChampionID
+	if expectedMastery != actualMastery {
+		t.Errorf("\nExpected: %d\nActual: %d\n", expectedMastery, actualMastery)
+		return
+	}
+}
+
+func TestScoutClashTeamMemberFailure(t *testing.T) {
+	rec, err := recorder.New("cassettes/lol/clash-v1/scout-clash-team-rate-limited")
+	if err != nil {
+		log.Fatal(err)
+	} else {
+		rec.SetMatcher(matchWithoutToken)
+		httpClient = &http.Client{Transport: rec}
+	}
+	rec.AddFilter(removeToken)
+	defer rec.Stop()
+	cli, err := NewClient(testToken, WithHTTPClient(httpClient))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	scout, resp, err := cli.ScoutClashTeam(clashTeamID)
+	if resp.StatusCode != 200 {
+		t.Errorf("\nExpected: 200 status code\nActual: %d status code", resp.StatusCode)
+		return
+	}
+	if scout != nil {
+		t.Errorf("\nExpected: nil scout\nActual: %v\n", scout)
+		return
+	}
+	expected := "lol: scouting Qm1DxQ9kDbpAAvH1C5i1l3ZLJcN6vDfR4K0vYFvXb2T4gA0 league entries failed: 429 Too Many Requests"
+	if err == nil || err.Error() != expected {
+		t.Errorf("\nExpected: %s\nActual: %v\n", expected, err)
+		return
+	}
+}
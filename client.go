@@ -1,7 +1,9 @@
 package lol
 
 import (
+	"fmt"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/dghubble/sling"
@@ -12,9 +14,30 @@ const (
 	defaultRegion      = "na1"
 	maxIdleConnections = 10
 	requestTimeout     = 5
+	tournamentRoute    = "americas"
 )
 
 var (
+	// regionalRoutes maps a platform to the regional routing value that serves it
+	regionalRoutes = map[string]string{
+		"br1":  "americas",
+		"la1":  "americas",
+		"la2":  "americas",
+		"na1":  "americas",
+		"jp1":  "asia",
+		"kr":   "asia",
+		"eun1": "europe",
+		"euw1": "europe",
+		"ru":   "europe",
+		"tr1":  "europe",
+		"oc1":  "sea",
+		"ph2":  "sea",
+		"sg2":  "sea",
+		"th2":  "sea",
+		"tw2":  "sea",
+		"vn2":  "sea",
+	}
+
 	// DefaultHTTPClient default http client to use
 	DefaultHTTPClient = &http.Client{
 		Transport: &http.Transport{
@@ -29,11 +52,17 @@ type ClientOption func(*Client) error
 
 // Client API struct to League of Legends
 type Client struct {
-	Token, Region string
-	sling         *sling.Sling
-	httpClient    *http.Client
+	Token, Region  string
+	sling          *sling.Sling
+	httpClient     *http.Client
+	tournamentStub bool
+	valShard       string
 	*LOL
 	*TFT
+	*Tournament
+	*Account
+	*LOR
+	*VAL
 }
 
 // NewClient returns interface to League of Legends API
@@ -41,7 +70,7 @@ func NewClient(token string, options ...ClientOption) (*Client, error) {
 	cli := &Client{}
 	WithToken(token)(cli)
 	WithRegion(defaultRegion)(cli)
-	cli.sling = sling.New().Base("https://" + cli.Region + "." + baseURL)
+	cli.sling = sling.New()
 	cli.sling.Set("User-Agent", "jonwho/lol")
 
 	for _, option := range options {
@@ -50,9 +79,23 @@ func NewClient(token string, options ...ClientOption) (*Client, error) {
 		}
 	}
 
+	cli.sling.Base("https://" + cli.Region + "." + baseURL)
 	cli.sling.Set("X-Riot-Token", cli.Token)
-	cli.LOL = NewLOL(cli.sling)
-	cli.TFT = NewTFT(cli.sling)
+	regional := cli.sling.New().Base("https://" + regionalRoute(cli.Region) + "." + baseURL)
+	cli.LOL = NewLOLWithRegion(cli.sling, regional)
+	cli.TFT = NewTFTWithRegion(cli.sling, regional)
+	tournament := cli.sling.New().Base("https://" + tournamentRoute + "." + baseURL)
+	if cli.tournamentStub {
+		cli.Tournament = NewTournamentStub(tournament)
+	} else {
+		cli.Tournament = NewTournament(tournament)
+	}
+	cli.Account = NewAccount(cli.sling.New().Base("https://" + accountRoute(cli.Region) + "." + baseURL))
+	cli.LOR = NewLOR(cli.sling.New().Base("https://" + lorRoute(cli.Region) + "." + baseURL))
+	if cli.valShard == "" {
+		cli.valShard = valShard(cli.Region)
+	}
+	cli.VAL = cli.VALForShard(cli.valShard)
 
 	return cli, nil
 }
@@ -81,3 +124,96 @@ func WithHTTPClient(httpClient *http.Client) ClientOption {
 		return nil
 	}
 }
+
+// WithTournamentStub route tournament calls to tournament-stub-v4 instead of tournament-v4
+func WithTournamentStub() ClientOption {
+	return func(c *Client) error {
+		c.tournamentStub = true
+		return nil
+	}
+}
+
+// WithVALShard set the VALORANT shard, e.g. the ActiveShard of the player, instead of the shard
+// serving the client region
+func WithVALShard(shard string) ClientOption {
+	return func(c *Client) error {
+		shard = strings.ToLower(shard)
+		if !isVALShard(shard) {
+			return fmt.Errorf("lol: unknown VALORANT shard %q", shard)
+		}
+		c.valShard = shard
+		return nil
+	}
+}
+
+// VALForShard returns a VAL served by shard with the client token and http.Client, e.g. for the
+// ActiveShard of a player on another shard than the client's
+func (c *Client) VALForShard(shard string) *VAL {
+	return NewVAL(c.sling.New().Base("https://" + strings.ToLower(shard) + "." + baseURL))
+}
+
+// regionalRoute returns the regional routing value for region, defaulting to americas
+func regionalRoute(region string) string {
+	if route, ok := regionalRoutes[strings.ToLower(region)]; ok {
+		return route
+	}
+	return "americas"
+}
+
+// routedSling returns a copy of s based on the routing value route returns for the platform s is
+// based on, for services built from a platform sling. Slings already based on a routing value are
+// copied as is, as are slings not based on the Riot API, e.g. a proxy. An unknown platform is
+// served by americas.
+func routedSling(s *sling.Sling, route func(string) string) *sling.Sling {
+	req, err := s.New().Request()
+	if err != nil || !strings.HasSuffix(req.URL.Host, "."+strings.TrimSuffix(baseURL, "/")) {
+		return s.New()
+	}
+	host := strings.TrimSuffix(req.URL.Host, "."+strings.TrimSuffix(baseURL, "/"))
+	if _, ok := regionalRoutes[host]; !ok && isRoutingValue(host) {
+		return s.New()
+	}
+	return s.New().Base("https://" + route(host) + "." + baseURL)
+}
+
+// isRoutingValue reports whether host is a regional routing value rather than a platform
+func isRoutingValue(host string) bool {
+	for _, route := range regionalRoutes {
+		if route == host {
+			return true
+		}
+	}
+	return false
+}
+
+// accountRoute returns the regional routing value account-v1 serves region from.
+// account-v1 has no sea cluster so those platforms go to asia.
+func accountRoute(region string) string {
+	route := regionalRoute(region)
+	if route == "sea" {
+		return "asia"
+	}
+	return route
+}
+
+// lorRoute returns the regional routing value Legends of Runeterra serves region from.
+// LoR has no asia cluster so those platforms go to sea.
+func lorRoute(region string) string {
+	route := regionalRoute(region)
+	if route == "asia" {
+		return "sea"
+	}
+	return route
+}
+
+// post sends body JSON encoded to pathURL and decodes the response into successV.
+// successV may be nil for endpoints that respond without a body.
+func post(s *sling.Sling, pathURL string, body, successV interface{}) (*http.Response, error) {
+	return s.New().Post(pathURL).BodyJSON(body).Receive(successV, nil)
+}
+
+// put sends body JSON encoded to pathURL and decodes the response into successV.
+// successV may be nil for endpoints that respond without a body.
+func put(s *sling.Sling, pathURL string, body, successV interface{}) (*http.Response, error) {
+	return s.New().Put(pathURL).BodyJSON(body).Receive(successV, nil)
+}
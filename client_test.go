@@ -2,6 +2,8 @@ package lol
 
 import (
 	"testing"
+
+	"github.com/dghubble/sling"
 )
 
 func TestNewClient(t *testing.T) {
@@ -47,3 +49,103 @@ func TestNewClient(t *testing.T) {
 		return
 	}
 }
+
+func TestRegionalRoute(t *testing.T) {
+	tests := []struct {
+		region, route, accountRoute, lorRoute string
+	}{
+		{"na1", "americas", "americas", "americas"},
+		{"EUW1", "europe", "europe", "europe"},
+		{"kr", "asia", "asia", "sea"},
+		{"oc1", "sea", "asia", "sea"},
+		{"mynewregion", "americas", "americas", "americas"},
+	}
+	for _, test := range tests {
+		expected := test.route
+		actual := regionalRoute(test.region)
+		if expected != actual {
+			t.Errorf("\nExpected: %s\nActual: %s\n", expected, actual)
+			return
+		}
+		expected = test.accountRoute
+		actual = accountRoute(test.region)
+		if expected != actual {
+			t.Errorf("\nExpected: %s\nActual: %s\n", expected, actual)
+			return
+		}
+		expected = test.lorRoute
+		actual = lorRoute(test.region)
+		if expected != actual {
+			t.Errorf("\nExpected: %s\nActual: %s\n", expected, actual)
+			return
+		}
+	}
+}
+
+func TestNewClientRegionBaseURL(t *testing.T) {
+	cli, err := NewClient("test_key", WithRegion("euw1"))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	req, err := cli.LOL.sling.New().Get("status/v3/shard-data").Request()
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	expected := "https://euw1.api.riotgames.com/lol/status/v3/shard-data"
+	actual := req.URL.String()
+	if expected != actual {
+		t.Errorf("\nExpected: %s\nActual: %s\n", expected, actual)
+		return
+	}
+	req, err = cli.Account.sling.New().Get("accounts/by-puuid/abc").Request()
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	expected = "https://europe.api.riotgames.com/riot/account/v1/accounts/by-puuid/abc"
+	actual = req.URL.String()
+	if expected != actual {
+		t.Errorf("\nExpected: %s\nActual: %s\n", expected, actual)
+		return
+	}
+}
+
+func TestRoutedSling(t *testing.T) {
+	tests := []struct {
+		base, expected string
+	}{
+		{"https://kr.api.riotgames.com/", "asia.api.riotgames.com"},
+		{"https://na1.api.riotgames.com/", "americas.api.riotgames.com"},
+		{"https://mynewregion.api.riotgames.com/", "americas.api.riotgames.com"},
+		{"https://europe.api.riotgames.com/", "europe.api.riotgames.com"},
+		{"http://localhost:8080/", "localhost:8080"},
+	}
+	for _, test := range tests {
+		tft := NewTFT(sling.New().Base(test.base))
+		req, err := tft.regional.New().Get("match/v1/matches/by-puuid/" + tftEncryptedPUUID + "/ids").Request()
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		actual := req.URL.Host
+		if test.expected != actual {
+			t.Errorf("\nExpected: %s\nActual: %s\n", test.expected, actual)
+			return
+		}
+	}
+
+	account := NewAccount(sling.New().Base("https://oc1.api.riotgames.com/"))
+	req, err := account.sling.New().Request()
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	expected := "https://asia.api.riotgames.com/riot/account/v1/"
+	actual := req.URL.String()
+	if expected != actual {
+		t.Errorf("\nExpected: %s\nActual: %s\n", expected, actual)
+		return
+	}
+}
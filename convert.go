@@ -0,0 +1,442 @@
+package lol
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// ConvertedDataVersion is the metadata.dataVersion set on matches and timelines made by ConvertMatch and ConvertTimeline
+const ConvertedDataVersion = "v4"
+
+var (
+	// MatchDroppedFields are the match-v4 fields ConvertMatch discards because match-v5 has no place for them
+	MatchDroppedFields = []string{
+		"seasonId",
+		"participantIdentities[].player.accountId",
+		"participantIdentities[].player.currentAccountId",
+		"participantIdentities[].player.currentPlatformId",
+		"participantIdentities[].player.matchHistoryUri",
+		"participantIdentities[].player.platformId",
+		"participants[].masteries",
+		"participants[].timeline",
+		"participants[].stats.combatPlayerScore",
+		"participants[].stats.firstInhibitorAssist",
+		"participants[].stats.firstInhibitorKill",
+		"participants[].stats.objectivePlayerScore",
+		"participants[].stats.playerScore0-9",
+		"participants[].stats.totalPlayerScore",
+		"participants[].stats.totalScoreRank",
+		"teams[].dominionVictoryScore",
+		"teams[].vilemawKills",
+	}
+
+	// MatchMissingFields are the match-v5 fields ConvertMatch cannot fill from match-v4 and leaves zero.
+	// info.gameEndTimestamp stays zero so info.gameDuration is in milliseconds, see MatchInfoV5DTO.Duration.
+	MatchMissingFields = []string{
+		"metadata.participants",
+		"info.endOfGameResult",
+		"info.gameEndTimestamp",
+		"info.gameName",
+		"info.gameStartTimestamp",
+		"info.tournamentCode",
+		"info.participants[].baronKills",
+		"info.participants[].bountyLevel",
+		"info.participants[].champExperience",
+		"info.participants[].championName",
+		"info.participants[].championTransform",
+		"info.participants[].consumablesPurchased",
+		"info.participants[].damageDealtToBuildings",
+		"info.participants[].detectorWardsPlaced",
+		"info.participants[].dragonKills",
+		"info.participants[].eligibleForProgression",
+		"info.participants[].gameEndedInEarlySurrender",
+		"info.participants[].gameEndedInSurrender",
+		"info.participants[].itemsPurchased",
+		"info.participants[].nexusKills",
+		"info.participants[].objectivesStolen",
+		"info.participants[].objectivesStolenAssists",
+		"info.participants[].puuid",
+		"info.participants[].riotIdGameName",
+		"info.participants[].riotIdTagline",
+		"info.participants[].summonerLevel",
+		"info.participants[].teamEarlySurrendered",
+		"info.participants[].timePlayed",
+		"info.participants[].totalDamageShieldedOnTeammates",
+		"info.participants[].totalHealsOnTeammates",
+		"info.participants[].totalTimeSpentDead",
+		"info.participants[].individualPosition",
+		"info.participants[].*Pings",
+		"info.participants[].spell1Casts-spell4Casts",
+		"info.participants[].summoner1Casts-summoner2Casts",
+		"info.participants[].*Takedowns",
+		"info.participants[].*Lost",
+		"info.teams[].objectives.horde",
+	}
+
+	// TimelineDroppedFields are the match-v4 timeline fields ConvertTimeline discards
+	TimelineDroppedFields = []string{
+		"frames[].participantFrames.*.dominionScore",
+		"frames[].participantFrames.*.teamScore",
+		"frames[].events[].ascendedType",
+		"frames[].events[].eventType",
+		"frames[].events[].pointCaptured",
+	}
+
+	// TimelineMissingFields are the match-v5 timeline fields ConvertTimeline cannot fill and leaves zero
+	TimelineMissingFields = []string{
+		"metadata.participants",
+		"info.endOfGameResult",
+		"info.participants[].puuid",
+		"info.frames[].participantFrames.*.championStats",
+		"info.frames[].participantFrames.*.damageStats",
+		"info.frames[].participantFrames.*.goldPerSecond",
+		"info.frames[].participantFrames.*.timeEnemySpentControlled",
+		"info.frames[].events[].bounty",
+		"info.frames[].events[].gameId",
+		"info.frames[].events[].goldGain",
+		"info.frames[].events[].killStreakLength",
+		"info.frames[].events[].killType",
+		// killerTeamId is only filled for ELITE_MONSTER_KILL events
+		"info.frames[].events[].killerTeamId",
+		"info.frames[].events[].level",
+		"info.frames[].events[].multiKillLength",
+		"info.frames[].events[].realTimestamp",
+		"info.frames[].events[].shutdownBounty",
+		"info.frames[].events[].transformType",
+		"info.frames[].events[].winningTeam",
+	}
+)
+
+// ConversionReport lists what a match-v4 to match-v5 conversion lost.
+// Dropped are v4 fields without a v5 counterpart, Missing are v5 fields left zero.
+// Besides the static field lists it names the individual values that could not be derived,
+// e.g. a teamPosition when the v4 lane and role guesses are ambiguous.
+type ConversionReport struct {
+	Dropped []string
+	Missing []string
+}
+
+func (r *ConversionReport) missing(format string, a ...interface{}) {
+	r.Missing = append(r.Missing, fmt.Sprintf(format, a...))
+}
+
+// ConvertMatch maps a match-v4 MatchDTO into the match-v5 shape.
+// Participant identities are folded into participants, stats are flattened and the
+// perk slots are grouped into primary and sub styles. The report lists what was lost.
+func ConvertMatch(match *MatchDTO) (*MatchV5DTO, *ConversionReport) {
+	report := &ConversionReport{
+		Dropped: append([]string(nil), MatchDroppedFields...),
+		Missing: append([]string(nil), MatchMissingFields...),
+	}
+
+	identities := make(map[int]PlayerDTO, len(match.ParticipantIdentities))
+	for _, identity := range match.ParticipantIdentities {
+		identities[identity.ParticipantID] = identity.Player
+	}
+
+	dto := &MatchV5DTO{
+		Metadata: MatchMetadataV5DTO{
+			DataVersion: ConvertedDataVersion,
+			MatchID:     v5MatchID(match.PlatformID, match.GameID),
+		},
+		Info: MatchInfoV5DTO{
+			GameCreation: match.GameCreation,
+			GameDuration: int64(match.GameDuration) * 1000,
+			GameID:       match.GameID,
+			GameMode:     match.GameMode,
+			GameType:     match.GameType,
+			GameVersion:  match.GameVersion,
+			MapID:        match.MapID,
+			PlatformID:   match.PlatformID,
+			QueueID:      match.QueueID,
+		},
+	}
+
+	kills := make(map[int]int)
+	for _, p := range match.Participants {
+		player, ok := identities[p.ParticipantID]
+		if !ok {
+			report.missing("info.participants[%d].summonerId", p.ParticipantID)
+		}
+		dto.Info.Participants = append(dto.Info.Participants, convertParticipant(p, player))
+		kills[p.TeamID] += p.Stats.Kills
+	}
+	assignTeamPositions(match.Participants, dto.Info.Participants, report)
+
+	for _, team := range match.Teams {
+		dto.Info.Teams = append(dto.Info.Teams, convertTeam(team, kills[team.TeamID]))
+	}
+	return dto, report
+}
+
+// ConvertTimeline maps a match-v4 MatchTimelineDTO into the match-v5 shape.
+// match-v4 timelines do not carry the game, so match is used for the match and game ID
+// and may be nil. Participant frames are keyed by their participantId like match-v5.
+func ConvertTimeline(timeline *MatchTimelineDTO, match *MatchDTO) (*TimelineV5DTO, *ConversionReport) {
+	report := &ConversionReport{
+		Dropped: append([]string(nil), TimelineDroppedFields...),
+		Missing: append([]string(nil), TimelineMissingFields...),
+	}
+
+	dto := &TimelineV5DTO{
+		Metadata: MatchMetadataV5DTO{DataVersion: ConvertedDataVersion},
+		Info:     TimelineInfoV5DTO{FrameInterval: int64(timeline.FrameInterval)},
+	}
+	if match != nil {
+		dto.Metadata.MatchID = v5MatchID(match.PlatformID, match.GameID)
+		dto.Info.GameID = match.GameID
+		for _, p := range match.Participants {
+			dto.Info.Participants = append(dto.Info.Participants, TimelineParticipantV5DTO{ParticipantID: p.ParticipantID})
+		}
+	} else {
+		report.Missing = append(report.Missing, "metadata.matchId", "info.gameId", "info.participants")
+	}
+
+	for _, frame := range timeline.Frames {
+		f := FrameV5DTO{
+			Timestamp:         frame.Timestamp,
+			ParticipantFrames: make(map[string]ParticipantFrameV5DTO, len(frame.ParticipantFrames)),
+		}
+		for _, pf := range frame.ParticipantFrames {
+			f.ParticipantFrames[strconv.Itoa(pf.ParticipantID)] = ParticipantFrameV5DTO{
+				CurrentGold:         pf.CurrentGold,
+				JungleMinionsKilled: pf.JungleMinionsKilled,
+				Level:               pf.Level,
+				MinionsKilled:       pf.MinionsKilled,
+				ParticipantID:       pf.ParticipantID,
+				Position:            pf.Position,
+				TotalGold:           pf.TotalGold,
+				XP:                  pf.XP,
+			}
+		}
+		for _, e := range frame.Events {
+			f.Events = append(f.Events, convertEvent(e))
+		}
+		dto.Info.Frames = append(dto.Info.Frames, f)
+	}
+	return dto, report
+}
+
+func v5MatchID(platformID string, gameID int64) string {
+	return platformID + "_" + strconv.FormatInt(gameID, 10)
+}
+
+func convertParticipant(p ParticipantDTO, player PlayerDTO) ParticipantV5DTO {
+	s := p.Stats
+	return ParticipantV5DTO{
+		Assists:                        s.Assists,
+		ChampLevel:                     s.ChampLevel,
+		ChampionID:                     p.ChampionID,
+		DamageDealtToObjectives:        s.DamageDealtToObjectives,
+		DamageDealtToTurrets:           s.DamageDealtToTurrets,
+		DamageSelfMitigated:            s.DamageSelfMitigated,
+		Deaths:                         s.Deaths,
+		DoubleKills:                    s.DoubleKills,
+		FirstBloodAssist:               s.FirstBloodAssist,
+		FirstBloodKill:                 s.FirstBloodKill,
+		FirstTowerAssist:               s.FirstTowerAssist,
+		FirstTowerKill:                 s.FirstTowerKill,
+		GoldEarned:                     s.GoldEarned,
+		GoldSpent:                      s.GoldSpent,
+		InhibitorKills:                 s.InhibitorKills,
+		Item0:                          s.Item0,
+		Item1:                          s.Item1,
+		Item2:                          s.Item2,
+		Item3:                          s.Item3,
+		Item4:                          s.Item4,
+		Item5:                          s.Item5,
+		Item6:                          s.Item6,
+		KillingSprees:                  s.KillingSprees,
+		Kills:                          s.Kills,
+		Lane:                           convertLane(p.Timeline.Lane),
+		LargestCriticalStrike:          s.LargestCriticalStrike,
+		LargestKillingSpree:            s.LargestKillingSpree,
+		LargestMultiKill:               s.LargestMultiKill,
+		LongestTimeSpentLiving:         s.LongestTimeSpentLiving,
+		MagicDamageDealt:               s.MagicDamageDealt,
+		MagicDamageDealtToChampions:    s.MagicDamageDealtToChampions,
+		MagicDamageTaken:               s.MagicalDamageTaken,
+		NeutralMinionsKilled:           s.NeutralMinionsKilled,
+		ParticipantID:                  p.ParticipantID,
+		PentaKills:                     s.PentaKills,
+		Perks:                          convertPerks(s),
+		PhysicalDamageDealt:            s.PhysicalDamageDealt,
+		PhysicalDamageDealtToChampions: s.PhysicalDamageDealtToChampions,
+		PhysicalDamageTaken:            s.PhysicalDamageTaken,
+		ProfileIcon:                    player.ProfileIcon,
+		QuadraKills:                    s.QuadraKills,
+		Role:                           convertRole(p.Timeline.Role),
+		SightWardsBoughtInGame:         s.SightWardsBoughtInGame,
+		Summoner1ID:                    p.Spell1ID,
+		Summoner2ID:                    p.Spell2ID,
+		SummonerID:                     player.SummonerID,
+		SummonerName:                   player.SummonerName,
+		TeamID:                         p.TeamID,
+		TimeCCingOthers:                s.TimeCCingOthers,
+		TotalAllyJungleMinionsKilled:   s.NeutralMinionsKilledTeamJungle,
+		TotalDamageDealt:               s.TotalDamageDealt,
+		TotalDamageDealtToChampions:    s.TotalDamageDealtToChampions,
+		TotalDamageTaken:               s.TotalDamageTaken,
+		TotalEnemyJungleMinionsKilled:  s.NeutralMinionsKilledEnemyJungle,
+		TotalHeal:                      s.TotalHeal,
+		TotalMinionsKilled:             s.TotalMinionsKilled,
+		TotalTimeCCDealt:               s.TotalTimeCrowdControlDealt,
+		TotalUnitsHealed:               s.TotalUnitsHealed,
+		TripleKills:                    s.TripleKills,
+		TrueDamageDealt:                s.TrueDamageDealt,
+		TrueDamageDealtToChampions:     s.TrueDamageDealtToChampions,
+		TrueDamageTaken:                s.TrueDamageTaken,
+		TurretKills:                    s.TurretKills,
+		UnrealKills:                    s.UnrealKills,
+		VisionScore:                    s.VisionScore,
+		VisionWardsBoughtInGame:        s.VisionWardsBoughtInGame,
+		WardsKilled:                    s.WardsKilled,
+		WardsPlaced:                    s.WardsPlaced,
+		Win:                            s.Win,
+	}
+}
+
+func convertPerks(s ParticipantStatsDTO) PerksV5DTO {
+	return PerksV5DTO{
+		StatPerks: PerkStatsV5DTO{
+			Offense: s.StatPerk0,
+			Flex:    s.StatPerk1,
+			Defense: s.StatPerk2,
+		},
+		Styles: []PerkStyleV5DTO{
+			{
+				Description: "primaryStyle",
+				Style:       s.PerkPrimaryStyle,
+				Selections: []PerkStyleSelectionV5DTO{
+					{Perk: s.Perk0, Var1: s.Perk0Var1, Var2: s.Perk0Var2, Var3: s.Perk0Var3},
+					{Perk: s.Perk1, Var1: s.Perk1Var1, Var2: s.Perk1Var2, Var3: s.Perk1Var3},
+					{Perk: s.Perk2, Var1: s.Perk2Var1, Var2: s.Perk2Var2, Var3: s.Perk2Var3},
+					{Perk: s.Perk3, Var1: s.Perk3Var1, Var2: s.Perk3Var2, Var3: s.Perk3Var3},
+				},
+			},
+			{
+				Description: "subStyle",
+				Style:       s.PerkSubStyle,
+				Selections: []PerkStyleSelectionV5DTO{
+					{Perk: s.Perk4, Var1: s.Perk4Var1, Var2: s.Perk4Var2, Var3: s.Perk4Var3},
+					{Perk: s.Perk5, Var1: s.Perk5Var1, Var2: s.Perk5Var2, Var3: s.Perk5Var3},
+				},
+			},
+		},
+	}
+}
+
+func convertTeam(team TeamStatsDTO, championKills int) TeamV5DTO {
+	dto := TeamV5DTO{
+		TeamID: team.TeamID,
+		Win:    team.Win == "Win",
+		Objectives: ObjectivesV5DTO{
+			Baron:      ObjectiveV5DTO{First: team.FirstBaron, Kills: team.BaronKills},
+			Champion:   ObjectiveV5DTO{First: team.FirstBlood, Kills: championKills},
+			Dragon:     ObjectiveV5DTO{First: team.FirstDragon, Kills: team.DragonKills},
+			Inhibitor:  ObjectiveV5DTO{First: team.FirstInhibitor, Kills: team.InhibitorKills},
+			RiftHerald: ObjectiveV5DTO{First: team.FirstRiftHerald, Kills: team.RiftHeraldKills},
+			Tower:      ObjectiveV5DTO{First: team.FirstTower, Kills: team.TowerKills},
+		},
+	}
+	for _, ban := range team.Bans {
+		dto.Bans = append(dto.Bans, BanV5DTO{ChampionID: ban.ChampionID, PickTurn: ban.PickTurn})
+	}
+	return dto
+}
+
+func convertEvent(e MatchEventDTO) EventV5DTO {
+	dto := EventV5DTO{
+		Timestamp:               e.Timestamp,
+		Type:                    e.Type,
+		ParticipantID:           e.ParticipantID,
+		ItemID:                  e.ItemID,
+		AfterID:                 e.AfterID,
+		BeforeID:                e.BeforeID,
+		SkillSlot:               e.SkillSlot,
+		LevelUpType:             e.LevelUpType,
+		WardType:                e.WardType,
+		CreatorID:               e.CreatorID,
+		KillerID:                e.KillerID,
+		VictimID:                e.VictimID,
+		AssistingParticipantIDs: e.AssistingParticipantIDs,
+		Position:                e.Position,
+		MonsterType:             e.MonsterType,
+		MonsterSubType:          e.MonsterSubType,
+		TeamID:                  e.TeamID,
+		BuildingType:            e.BuildingType,
+		LaneType:                e.LaneType,
+		TowerType:               e.TowerType,
+	}
+	if e.Type == "ELITE_MONSTER_KILL" {
+		dto.KillerTeamID = participantTeam(e.KillerID)
+	}
+	return dto
+}
+
+// participantTeam returns the team of a Summoner's Rift participant, 0 for minions and monsters
+func participantTeam(participantID int) int {
+	switch {
+	case participantID >= 1 && participantID <= 5:
+		return 100
+	case participantID >= 6 && participantID <= 10:
+		return 200
+	}
+	return 0
+}
+
+func convertLane(lane string) string {
+	switch lane {
+	case "MID":
+		return "MIDDLE"
+	case "BOT":
+		return "BOTTOM"
+	}
+	return lane
+}
+
+func convertRole(role string) string {
+	switch role {
+	case "DUO_CARRY":
+		return "CARRY"
+	case "DUO_SUPPORT":
+		return "SUPPORT"
+	}
+	return role
+}
+
+// assignTeamPositions sets teamPosition from the v4 lane and role guesses when they are
+// unambiguous within the team and reports every participant it could not place
+func assignTeamPositions(v4 []ParticipantDTO, v5 []ParticipantV5DTO, report *ConversionReport) {
+	guesses := make([]string, len(v4))
+	seen := make(map[int]map[string]int)
+	for i, p := range v4 {
+		guesses[i] = teamPosition(convertLane(p.Timeline.Lane), convertRole(p.Timeline.Role))
+		if seen[p.TeamID] == nil {
+			seen[p.TeamID] = make(map[string]int)
+		}
+		seen[p.TeamID][guesses[i]]++
+	}
+	for i, p := range v4 {
+		if guesses[i] == "" || seen[p.TeamID][guesses[i]] > 1 {
+			report.missing("info.participants[%d].teamPosition", p.ParticipantID)
+			continue
+		}
+		v5[i].TeamPosition = guesses[i]
+	}
+}
+
+func teamPosition(lane, role string) string {
+	switch lane {
+	case "TOP", "JUNGLE", "MIDDLE":
+		return lane
+	case "BOTTOM":
+		switch role {
+		case "CARRY":
+			return "BOTTOM"
+		case "SUPPORT":
+			return "UTILITY"
+		}
+	}
+	return ""
+}
@@ -0,0 +1,275 @@
+package lol
+
+import (
+	"log"
+	"net/http"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dnaeon/go-vcr/recorder"
+)
+
+func TestConvertMatch(t *testing.T) {
+	rec, err := recorder.New("cassettes/lol/match-v4/matches")
+	if err != nil {
+		log.Fatal(err)
+	} else {
+		rec.SetMatcher(matchWithoutToken)
+		httpClient = &http.Client{Transport: rec}
+	}
+	rec.AddFilter(removeToken)
+	defer rec.Stop()
+	cli, err := NewClient(testToken, WithHTTPClient(httpClient))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	match, _, err := cli.Matches(matchID)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	dto, report := ConvertMatch(match)
+	expected := "NA1_3198831326"
+	actual := dto.Metadata.MatchID
+	if expected != actual {
+		t.Errorf("\nExpected: %s\nActual: %s\n", expected, actual)
+		return
+	}
+	expected = "speareMENT"
+	actual = dto.Info.Participants[0].SummonerName
+	if expected != actual {
+		t.Errorf("\nExpected: %s\nActual: %s\n", expected, actual)
+		return
+	}
+	expected = "UTILITY"
+	actual = dto.Info.Participants[0].TeamPosition
+	if expected != actual {
+		t.Errorf("\nExpected: %s\nActual: %s\n", expected, actual)
+		return
+	}
+	expectedPerk := 8112
+	actualPerk := dto.Info.Participants[0].Perks.Styles[0].Selections[0].Perk
+	if expectedPerk != actualPerk {
+		t.Errorf("\nExpected: %d\nActual: %d\n", expectedPerk, actualPerk)
+		return
+	}
+	expectedKills := 55
+	actualKills := dto.Info.Teams[0].Objectives.Champion.Kills
+	if expectedKills != actualKills {
+		t.Errorf("\nExpected: %d\nActual: %d\n", expectedKills, actualKills)
+		return
+	}
+	if !dto.Info.Teams[0].Win {
+		t.Errorf("\nExpected: team 100 win\nActual: loss\n")
+		return
+	}
+	expectedDuration := 1813 * time.Second
+	actualDuration := dto.Info.Duration()
+	if expectedDuration != actualDuration {
+		t.Errorf("\nExpected: %v\nActual: %v\n", expectedDuration, actualDuration)
+		return
+	}
+
+	// both team 200 junglers are guessed as JUNGLE so neither gets a teamPosition
+	found := 0
+	for _, field := range report.Missing {
+		if field == "info.participants[7].teamPosition" || field == "info.participants[8].teamPosition" {
+			found++
+		}
+	}
+	if found != 2 {
+		t.Errorf("\nExpected: ambiguous junglers reported\nActual: %v\n", report.Missing)
+		return
+	}
+}
+
+func TestConvertTimeline(t *testing.T) {
+	rec, err := recorder.New("cassettes/lol/match-v4/timelines")
+	if err != nil {
+		log.Fatal(err)
+	} else {
+		rec.SetMatcher(matchWithoutToken)
+		httpClient = &http.Client{Transport: rec}
+	}
+	rec.AddFilter(removeToken)
+	defer rec.Stop()
+	cli, err := NewClient(testToken, WithHTTPClient(httpClient))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	timeline, _, err := cli.Timelines(matchID)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	dto, report := ConvertTimeline(timeline, nil)
+	expected := 3
+	actual := dto.Info.Frames[1].ParticipantFrames["3"].ParticipantID
+	if expected != actual {
+		t.Errorf("\nExpected: %d\nActual: %d\n", expected, actual)
+		return
+	}
+	expected = len(timeline.Frames)
+	actual = len(dto.Info.Frames)
+	if expected != actual {
+		t.Errorf("\nExpected: %d\nActual: %d\n", expected, actual)
+		return
+	}
+	for _, frame := range dto.Info.Frames {
+		for _, e := range frame.Events {
+			if e.Type == "ELITE_MONSTER_KILL" && e.KillerTeamID == 0 {
+				t.Errorf("\nExpected: killerTeamId set\nActual: %+v\n", e)
+				return
+			}
+		}
+	}
+	expectedMissing := "metadata.matchId"
+	actualMissing := report.Missing[len(report.Missing)-3]
+	if expectedMissing != actualMissing {
+		t.Errorf("\nExpected: %s\nActual: %s\n", expectedMissing, actualMissing)
+		return
+	}
+}
+
+func TestConvertMatchMissingFields(t *testing.T) {
+	match := new(MatchDTO)
+	fillNonZero(reflect.ValueOf(match).Elem())
+	match.Teams[0].Win = "Win"
+
+	dto, report := ConvertMatch(match)
+	if err := checkMissingFields(dto, report.Missing, MatchMissingFields); err != "" {
+		t.Error(err)
+		return
+	}
+}
+
+func TestConvertTimelineMissingFields(t *testing.T) {
+	match := new(MatchDTO)
+	fillNonZero(reflect.ValueOf(match).Elem())
+	timeline := new(MatchTimelineDTO)
+	fillNonZero(reflect.ValueOf(timeline).Elem())
+
+	dto, report := ConvertTimeline(timeline, match)
+	if err := checkMissingFields(dto, report.Missing, TimelineMissingFields); err != "" {
+		t.Error(err)
+		return
+	}
+}
+
+// fillNonZero sets every field reachable from v to a non-zero value, slices and maps get one entry
+func fillNonZero(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).PkgPath == "" {
+				fillNonZero(v.Field(i))
+			}
+		}
+	case reflect.Slice:
+		v.Set(reflect.MakeSlice(v.Type(), 1, 1))
+		fillNonZero(v.Index(0))
+	case reflect.Map:
+		v.Set(reflect.MakeMap(v.Type()))
+		key, elem := reflect.New(v.Type().Key()).Elem(), reflect.New(v.Type().Elem()).Elem()
+		fillNonZero(key)
+		fillNonZero(elem)
+		v.SetMapIndex(key, elem)
+	case reflect.String:
+		v.SetString("1")
+	case reflect.Int, reflect.Int64:
+		v.SetInt(1)
+	case reflect.Float64:
+		v.SetFloat(1)
+	case reflect.Bool:
+		v.SetBool(true)
+	}
+}
+
+// zeroFields returns the JSON path of every zero field reachable from v, e.g. info.participants[].puuid
+func zeroFields(v reflect.Value, path string) []string {
+	switch v.Kind() {
+	case reflect.Struct:
+		var paths []string
+		for i := 0; i < v.NumField(); i++ {
+			name := strings.Split(v.Type().Field(i).Tag.Get("json"), ",")[0]
+			if path != "" {
+				name = path + "." + name
+			}
+			paths = append(paths, zeroFields(v.Field(i), name)...)
+		}
+		return paths
+	case reflect.Slice:
+		if v.Len() == 0 {
+			return []string{path}
+		}
+		var paths []string
+		for i := 0; i < v.Len(); i++ {
+			paths = append(paths, zeroFields(v.Index(i), path+"[]")...)
+		}
+		return paths
+	case reflect.Map:
+		if v.Len() == 0 {
+			return []string{path}
+		}
+		var paths []string
+		for _, key := range v.MapKeys() {
+			paths = append(paths, zeroFields(v.MapIndex(key), path+".*")...)
+		}
+		return paths
+	}
+	if v.IsZero() {
+		return []string{path}
+	}
+	return nil
+}
+
+var (
+	missingFieldIndex = regexp.MustCompile(`\[\d+\]`)
+	missingFieldRange = regexp.MustCompile(`([A-Za-z]+)(\d)([A-Za-z]*)-[A-Za-z]+(\d)[A-Za-z]*$`)
+)
+
+// missingFieldPattern turns a reported field like info.participants[].*Pings into a regexp that
+// also matches the fields nested in it
+func missingFieldPattern(field string) *regexp.Regexp {
+	field = missingFieldIndex.ReplaceAllString(field, "[]")
+	pattern := regexp.QuoteMeta(field)
+	pattern = strings.Replace(pattern, `\*`, `[A-Za-z0-9*]*`, -1)
+	pattern = missingFieldRange.ReplaceAllString(pattern, `$1[$2-$4]$3`)
+	return regexp.MustCompile("^" + pattern + `($|\.|\[\])`)
+}
+
+// checkMissingFields fails for zero fields of dto the report does not list, and for fields of
+// the static list that are not zero
+func checkMissingFields(dto interface{}, reported, static []string) string {
+	patterns := make([]*regexp.Regexp, len(reported))
+	for i, field := range reported {
+		patterns[i] = missingFieldPattern(field)
+	}
+	zero := zeroFields(reflect.ValueOf(dto).Elem(), "")
+	for _, path := range zero {
+		listed := false
+		for _, p := range patterns {
+			listed = listed || p.MatchString(path)
+		}
+		if !listed {
+			return "zero field " + path + " is not listed as missing"
+		}
+	}
+	for _, field := range static {
+		p, found := missingFieldPattern(field), false
+		for _, path := range zero {
+			found = found || p.MatchString(path)
+		}
+		if !found {
+			return "field " + field + " is listed as missing but filled"
+		}
+	}
+	return ""
+}
@@ -0,0 +1,428 @@
+package lol
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"github.com/dghubble/sling"
+)
+
+const dataDragonURL = "https://ddragon.leagueoflegends.com/"
+
+// DataDragon provides methods to load static data from Data Dragon or from an extracted
+// dragontail bundle on disk
+type DataDragon struct {
+	sling   *sling.Sling
+	locale  string
+	bundle  string
+	mu      sync.Mutex
+	version string
+}
+
+// DataDragonOption is a func that operates on *DataDragon
+type DataDragonOption func(*DataDragon) error
+
+type Image struct {
+	Full   string `json:"full"`
+	Sprite string `json:"sprite"`
+	Group  string `json:"group"`
+	X      int    `json:"x"`
+	Y      int    `json:"y"`
+	W      int    `json:"w"`
+	H      int    `json:"h"`
+}
+
+type ChampionList struct {
+	Type    string              `json:"type"`
+	Format  string              `json:"format"`
+	Version string              `json:"version"`
+	Data    map[string]Champion `json:"data"`
+}
+
+type Champion struct {
+	Version string             `json:"version"`
+	ID      string             `json:"id"`
+	Key     string             `json:"key"`
+	Name    string             `json:"name"`
+	Title   string             `json:"title"`
+	Blurb   string             `json:"blurb"`
+	Info    ChampionRatings    `json:"info"`
+	Image   Image              `json:"image"`
+	Tags    []string           `json:"tags"`
+	Partype string             `json:"partype"`
+	Stats   map[string]float64 `json:"stats"`
+}
+
+type ChampionRatings struct {
+	Attack     int `json:"attack"`
+	Defense    int `json:"defense"`
+	Magic      int `json:"magic"`
+	Difficulty int `json:"difficulty"`
+}
+
+type ItemList struct {
+	Type    string          `json:"type"`
+	Version string          `json:"version"`
+	Data    map[string]Item `json:"data"`
+}
+
+type Item struct {
+	Name             string             `json:"name"`
+	Description      string             `json:"description"`
+	Colloq           string             `json:"colloq"`
+	Plaintext        string             `json:"plaintext"`
+	Into             []string           `json:"into"`
+	From             []string           `json:"from"`
+	Image            Image              `json:"image"`
+	Gold             ItemGold           `json:"gold"`
+	Tags             []string           `json:"tags"`
+	Maps             map[string]bool    `json:"maps"`
+	Stats            map[string]float64 `json:"stats"`
+	Depth            int                `json:"depth"`
+	Consumed         bool               `json:"consumed"`
+	RequiredChampion string             `json:"requiredChampion"`
+	SpecialRecipe    int                `json:"specialRecipe"`
+}
+
+type ItemGold struct {
+	Base        int  `json:"base"`
+	Purchasable bool `json:"purchasable"`
+	Total       int  `json:"total"`
+	Sell        int  `json:"sell"`
+}
+
+type RuneTree struct {
+	ID    int        `json:"id"`
+	Key   string     `json:"key"`
+	Icon  string     `json:"icon"`
+	Name  string     `json:"name"`
+	Slots []RuneSlot `json:"slots"`
+}
+
+type RuneSlot struct {
+	Runes []Rune `json:"runes"`
+}
+
+type Rune struct {
+	ID        int    `json:"id"`
+	Key       string `json:"key"`
+	Icon      string `json:"icon"`
+	Name      string `json:"name"`
+	ShortDesc string `json:"shortDesc"`
+	LongDesc  string `json:"longDesc"`
+}
+
+type SummonerSpellList struct {
+	Type    string                   `json:"type"`
+	Version string                   `json:"version"`
+	Data    map[string]SummonerSpell `json:"data"`
+}
+
+type SummonerSpell struct {
+	ID            string    `json:"id"`
+	Name          string    `json:"name"`
+	Description   string    `json:"description"`
+	Tooltip       string    `json:"tooltip"`
+	MaxRank       int       `json:"maxrank"`
+	Cooldown      []float64 `json:"cooldown"`
+	CooldownBurn  string    `json:"cooldownBurn"`
+	Key           string    `json:"key"`
+	SummonerLevel int       `json:"summonerLevel"`
+	Modes         []string  `json:"modes"`
+	Range         []float64 `json:"range"`
+	Image         Image     `json:"image"`
+}
+
+type ProfileIconList struct {
+	Type    string                 `json:"type"`
+	Version string                 `json:"version"`
+	Data    map[string]ProfileIcon `json:"data"`
+}
+
+type ProfileIcon struct {
+	ID    int   `json:"id"`
+	Image Image `json:"image"`
+}
+
+type MapList struct {
+	Type    string             `json:"type"`
+	Version string             `json:"version"`
+	Data    map[string]MapInfo `json:"data"`
+}
+
+type MapInfo struct {
+	MapName string `json:"MapName"`
+	MapID   string `json:"MapId"`
+	Image   Image  `json:"image"`
+}
+
+// NewDataDragon returns a new DataDragon. Without WithDataDragonVersion the latest version is
+// looked up on first use, or the newest version found in the bundle when loading offline.
+func NewDataDragon(options ...DataDragonOption) (*DataDragon, error) {
+	d := &DataDragon{locale: DefaultLocale}
+	d.sling = sling.New().Client(DefaultHTTPClient).Base(dataDragonURL)
+	d.sling.Set("User-Agent", "jonwho/lol")
+
+	for _, option := range options {
+		if err := option(d); err != nil {
+			return nil, err
+		}
+	}
+
+	if d.bundle != "" && d.version == "" {
+		versions, err := bundleVersions(d.bundle)
+		if err != nil {
+			return nil, err
+		}
+		d.version = versions[0]
+	}
+	return d, nil
+}
+
+// WithDataDragonVersion pin the Data Dragon version, e.g. 9.22.1
+func WithDataDragonVersion(version string) DataDragonOption {
+	return func(d *DataDragon) error {
+		d.version = version
+		return nil
+	}
+}
+
+// WithDataDragonLocale set the locale static data is loaded in, e.g. ko_KR, DefaultLocale by default
+func WithDataDragonLocale(locale string) DataDragonOption {
+	return func(d *DataDragon) error {
+		d.locale = locale
+		return nil
+	}
+}
+
+// WithDataDragonHTTPClient set the http.Client used to reach Data Dragon
+func WithDataDragonHTTPClient(httpClient *http.Client) DataDragonOption {
+	return func(d *DataDragon) error {
+		d.sling.Client(httpClient)
+		return nil
+	}
+}
+
+// WithDataDragonBundle load static data from an extracted dragontail bundle instead of the CDN.
+// dir is the bundle root, the directory holding {version}/data/{locale}/.
+func WithDataDragonBundle(dir string) DataDragonOption {
+	return func(d *DataDragon) error {
+		info, err := os.Stat(dir)
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() {
+			return fmt.Errorf("lol: data dragon bundle %s is not a directory", dir)
+		}
+		d.bundle = dir
+		return nil
+	}
+}
+
+// Versions GET /api/versions.json, newest first. A bundle only has its own version.
+func (d *DataDragon) Versions() ([]string, error) {
+	if d.bundle != "" {
+		return bundleVersions(d.bundle)
+	}
+	versions := new([]string)
+	if err := d.get("api/versions.json", versions); err != nil {
+		return nil, err
+	}
+	return *versions, nil
+}
+
+// Languages GET /cdn/languages.json, the locales static data is published in.
+// A bundle reads the languages.json at its root.
+func (d *DataDragon) Languages() ([]string, error) {
+	languages := new([]string)
+	if d.bundle != "" {
+		b, err := ioutil.ReadFile(filepath.Join(d.bundle, "languages.json"))
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(b, languages); err != nil {
+			return nil, err
+		}
+		return *languages, nil
+	}
+	if err := d.get("cdn/languages.json", languages); err != nil {
+		return nil, err
+	}
+	return *languages, nil
+}
+
+// Version returns the version static data is loaded from
+func (d *DataDragon) Version() (string, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.version != "" {
+		return d.version, nil
+	}
+	versions, err := d.Versions()
+	if err != nil {
+		return "", err
+	}
+	if len(versions) == 0 {
+		return "", errors.New("lol: data dragon returned no versions")
+	}
+	d.version = versions[0]
+	return d.version, nil
+}
+
+// Locale returns the locale static data is loaded in
+func (d *DataDragon) Locale() string {
+	return d.locale
+}
+
+// Champions GET /cdn/{version}/data/{locale}/champion.json
+func (d *DataDragon) Champions() (*ChampionList, error) {
+	dto := new(ChampionList)
+	if err := d.data("champion.json", dto); err != nil {
+		return nil, err
+	}
+	return dto, nil
+}
+
+// Items GET /cdn/{version}/data/{locale}/item.json
+func (d *DataDragon) Items() (*ItemList, error) {
+	dto := new(ItemList)
+	if err := d.data("item.json", dto); err != nil {
+		return nil, err
+	}
+	return dto, nil
+}
+
+// Runes GET /cdn/{version}/data/{locale}/runesReforged.json
+func (d *DataDragon) Runes() ([]RuneTree, error) {
+	trees := new([]RuneTree)
+	if err := d.data("runesReforged.json", trees); err != nil {
+		return nil, err
+	}
+	return *trees, nil
+}
+
+// SummonerSpells GET /cdn/{version}/data/{locale}/summoner.json
+func (d *DataDragon) SummonerSpells() (*SummonerSpellList, error) {
+	dto := new(SummonerSpellList)
+	if err := d.data("summoner.json", dto); err != nil {
+		return nil, err
+	}
+	return dto, nil
+}
+
+// ProfileIcons GET /cdn/{version}/data/{locale}/profileicon.json
+func (d *DataDragon) ProfileIcons() (*ProfileIconList, error) {
+	dto := new(ProfileIconList)
+	if err := d.data("profileicon.json", dto); err != nil {
+		return nil, err
+	}
+	return dto, nil
+}
+
+// Maps GET /cdn/{version}/data/{locale}/map.json
+func (d *DataDragon) Maps() (*MapList, error) {
+	dto := new(MapList)
+	if err := d.data("map.json", dto); err != nil {
+		return nil, err
+	}
+	return dto, nil
+}
+
+// data loads file for the configured version and locale from the bundle or the CDN
+func (d *DataDragon) data(file string, v interface{}) error {
+	version, err := d.Version()
+	if err != nil {
+		return err
+	}
+	if d.bundle != "" {
+		b, err := ioutil.ReadFile(filepath.Join(d.bundle, version, "data", d.locale, file))
+		if err != nil {
+			return err
+		}
+		return json.Unmarshal(b, v)
+	}
+	return d.get("cdn/"+version+"/data/"+d.locale+"/"+file, v)
+}
+
+func (d *DataDragon) get(pathURL string, v interface{}) error {
+	resp, err := d.sling.New().Get(pathURL).Receive(v, nil)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("lol: data dragon request failed: %s", resp.Status)
+	}
+	return nil
+}
+
+// bundleVersions returns the versions in a dragontail bundle newest first
+func bundleVersions(dir string) ([]string, error) {
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var versions []string
+	for _, e := range entries {
+		if !e.IsDir() {
+			continue
+		}
+		if info, err := os.Stat(filepath.Join(dir, e.Name(), "data")); err == nil && info.IsDir() {
+			versions = append(versions, e.Name())
+		}
+	}
+	if len(versions) == 0 {
+		return nil, fmt.Errorf("lol: no data dragon version found in %s", dir)
+	}
+	sort.Slice(versions, func(i, j int) bool { return compareVersions(versions[i], versions[j]) > 0 })
+	return versions, nil
+}
+
+// compareVersions compares dotted numeric versions like 9.22.1, returning -1, 0 or 1
+func compareVersions(a, b string) int {
+	as, bs := splitVersion(a), splitVersion(b)
+	for i := 0; i < len(as) || i < len(bs); i++ {
+		var x, y int
+		if i < len(as) {
+			x = as[i]
+		}
+		if i < len(bs) {
+			y = bs[i]
+		}
+		switch {
+		case x < y:
+			return -1
+		case x > y:
+			return 1
+		}
+	}
+	return 0
+}
+
+func splitVersion(version string) []int {
+	var parts []int
+	n, digits := 0, false
+	for _, r := range version {
+		if r >= '0' && r <= '9' {
+			n = n*10 + int(r-'0')
+			digits = true
+			continue
+		}
+		if r == '.' && digits {
+			parts = append(parts, n)
+			n, digits = 0, false
+			continue
+		}
+		break
+	}
+	if digits {
+		parts = append(parts, n)
+	}
+	return parts
+}
@@ -0,0 +1,213 @@
+package lol
+
+import (
+	"log"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/dnaeon/go-vcr/recorder"
+)
+
+const dragontailBundle = "testdata/dragontail-9.22.1"
+
+func newBundleDataDragon(t *testing.T) *DataDragon {
+	dd, err := NewDataDragon(WithDataDragonBundle(dragontailBundle))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return dd
+}
+
+func TestDataDragonVersions(t *testing.T) {
+	rec, err := recorder.New("cassettes/ddragon/versions")
+	if err != nil {
+		log.Fatal(err)
+	}
+	rec.SetMatcher(matchWithoutToken)
+	defer rec.Stop()
+	dd, err := NewDataDragon(WithDataDragonHTTPClient(&http.Client{Transport: rec}))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	versions, err := dd.Versions()
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	expected := "9.22.1"
+	actual := versions[0]
+	if expected != actual {
+		t.Errorf("\nExpected: %s\nActual: %s\n", expected, actual)
+		return
+	}
+}
+
+func TestDataDragonChampions(t *testing.T) {
+	rec, err := recorder.New("cassettes/ddragon/champions")
+	if err != nil {
+		log.Fatal(err)
+	}
+	rec.SetMatcher(matchWithoutToken)
+	defer rec.Stop()
+	dd, err := NewDataDragon(WithDataDragonHTTPClient(&http.Client{Transport: rec}))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	champions, err := dd.Champions()
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	expected := "Bard"
+	actual := champions.Data["Bard"].Name
+	if expected != actual {
+		t.Errorf("\nExpected: %s\nActual: %s\n", expected, actual)
+		return
+	}
+}
+
+func TestDataDragonBundle(t *testing.T) {
+	dd := newBundleDataDragon(t)
+
+	version, err := dd.Version()
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if expected, actual := "9.22.1", version; expected != actual {
+		t.Errorf("\nExpected: %s\nActual: %s\n", expected, actual)
+		return
+	}
+
+	champions, err := dd.Champions()
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if expected, actual := "432", champions.Data["Bard"].Key; expected != actual {
+		t.Errorf("\nExpected: %s\nActual: %s\n", expected, actual)
+		return
+	}
+
+	items, err := dd.Items()
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if expected, actual := "Infinity Edge", items.Data["3031"].Name; expected != actual {
+		t.Errorf("\nExpected: %s\nActual: %s\n", expected, actual)
+		return
+	}
+
+	runes, err := dd.Runes()
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if expected, actual := 5, len(runes); expected != actual {
+		t.Errorf("\nExpected: %d\nActual: %d\n", expected, actual)
+		return
+	}
+
+	spells, err := dd.SummonerSpells()
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if expected, actual := "4", spells.Data["SummonerFlash"].Key; expected != actual {
+		t.Errorf("\nExpected: %s\nActual: %s\n", expected, actual)
+		return
+	}
+
+	icons, err := dd.ProfileIcons()
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if expected, actual := 607, icons.Data["607"].ID; expected != actual {
+		t.Errorf("\nExpected: %d\nActual: %d\n", expected, actual)
+		return
+	}
+
+	maps, err := dd.Maps()
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if expected, actual := "Summoner's Rift", maps.Data["11"].MapName; expected != actual {
+		t.Errorf("\nExpected: %s\nActual: %s\n", expected, actual)
+		return
+	}
+}
+
+func TestDataDragonLanguages(t *testing.T) {
+	rec, err := recorder.New("cassettes/ddragon/languages")
+	if err != nil {
+		log.Fatal(err)
+	}
+	rec.SetMatcher(matchWithoutToken)
+	defer rec.Stop()
+	dd, err := NewDataDragon(WithDataDragonHTTPClient(&http.Client{Transport: rec}))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	languages, err := dd.Languages()
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if expected, actual := "en_US", languages[0]; expected != actual {
+		t.Errorf("\nExpected: %s\nActual: %s\n", expected, actual)
+		return
+	}
+}
+
+func TestDataDragonBundleLanguages(t *testing.T) {
+	dd := newBundleDataDragon(t)
+
+	languages, err := dd.Languages()
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if expected, actual := "en_US ko_KR pt_BR", strings.Join(languages, " "); expected != actual {
+		t.Errorf("\nExpected: %s\nActual: %s\n", expected, actual)
+		return
+	}
+}
+
+func TestDataDragonBundleMissingLocale(t *testing.T) {
+	dd, err := NewDataDragon(WithDataDragonBundle(dragontailBundle), WithDataDragonLocale("ko_KR"))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if _, err := dd.Champions(); err == nil {
+		t.Error("\nExpected: error for locale missing from bundle\nActual: nil")
+	}
+}
+
+func TestCompareVersions(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected int
+	}{
+		{"9.22.1", "9.3.1", 1},
+		{"9.7.1", "9.7.2", -1},
+		{"10.1.1", "10.1.1", 0},
+		{"lolpatch_3.7", "0.151.2", -1},
+	}
+	for _, test := range tests {
+		actual := compareVersions(test.a, test.b)
+		if test.expected != actual {
+			t.Errorf("\nExpected: %d\nActual: %d\n", test.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,237 @@
+package lol
+
+import (
+	"encoding/base32"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+)
+
+const (
+	deckCodeFormat = 1
+	// maxDeckCodeVersion is the newest deck code version DecodeDeck understands
+	maxDeckCodeVersion = 5
+)
+
+var (
+	// ErrInvalidDeckCode returned when a deck code is not valid base32 or is truncated
+	ErrInvalidDeckCode = errors.New("lol: invalid deck code")
+
+	deckCodeEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+	// deckFactions maps the faction identifier used in deck codes to the card code faction
+	// and the first deck code version that supports it
+	deckFactions = map[uint64]deckFaction{
+		0:  {"DE", 1},
+		1:  {"FR", 1},
+		2:  {"IO", 1},
+		3:  {"NX", 1},
+		4:  {"PZ", 1},
+		5:  {"SI", 1},
+		6:  {"BW", 2},
+		7:  {"SH", 3},
+		9:  {"MT", 2},
+		10: {"BC", 4},
+		12: {"RU", 5},
+	}
+)
+
+type deckFaction struct {
+	code    string
+	version int
+}
+
+// CardCodeAndCount is a card of a Legends of Runeterra deck, CardCode looks like 01DE001
+type CardCodeAndCount struct {
+	CardCode string
+	Count    int
+}
+
+// Deck is a list of cards as stored in a LoR deck code
+type Deck []CardCodeAndCount
+
+// deckCard is a card code split into its set, faction and number
+type deckCard struct {
+	set, faction, number uint64
+	count                int
+	code                 string
+}
+
+// DecodeDeck decodes a Legends of Runeterra deck code into its cards.
+// Cards are returned in the order they are stored in the code.
+func DecodeDeck(code string) (Deck, error) {
+	b, err := deckCodeEncoding.DecodeString(code)
+	if err != nil || len(b) == 0 {
+		return nil, ErrInvalidDeckCode
+	}
+	format, version := int(b[0]>>4), int(b[0]&0xf)
+	if format != deckCodeFormat || version > maxDeckCodeVersion {
+		return nil, fmt.Errorf("lol: unsupported deck code format %d version %d", format, version)
+	}
+
+	r := &varintReader{b: b[1:]}
+	var deck Deck
+	for count := 3; count > 0; count-- {
+		groups := r.next()
+		for i := uint64(0); i < groups && r.err == nil; i++ {
+			size, set, faction := r.next(), r.next(), r.next()
+			for j := uint64(0); j < size && r.err == nil; j++ {
+				card, err := deckCardCode(set, faction, r.next())
+				if err != nil {
+					return nil, err
+				}
+				deck = append(deck, CardCodeAndCount{CardCode: card, Count: count})
+			}
+		}
+	}
+	// cards with more than 3 copies are stored one by one after the groups
+	for r.err == nil && len(r.b) > 0 {
+		count, set, faction, number := r.next(), r.next(), r.next(), r.next()
+		if r.err != nil {
+			break
+		}
+		card, err := deckCardCode(set, faction, number)
+		if err != nil {
+			return nil, err
+		}
+		deck = append(deck, CardCodeAndCount{CardCode: card, Count: int(count)})
+	}
+	if r.err != nil {
+		return nil, r.err
+	}
+	return deck, nil
+}
+
+// EncodeDeck encodes deck as a Legends of Runeterra deck code using the oldest version
+// that supports every faction in the deck. Cards with the same count, set and faction are
+// grouped, smaller groups first and in the order they first appear in deck.
+func EncodeDeck(deck Deck) (string, error) {
+	version := 1
+	byCount := map[int][][]deckCard{}
+	var many []deckCard
+	for _, c := range deck {
+		card, err := parseDeckCard(c)
+		if err != nil {
+			return "", err
+		}
+		if v := deckFactions[card.faction].version; v > version {
+			version = v
+		}
+		if card.count > 3 {
+			many = append(many, card)
+			continue
+		}
+		groups := byCount[card.count]
+		found := false
+		for i, g := range groups {
+			if g[0].set == card.set && g[0].faction == card.faction {
+				groups[i] = append(g, card)
+				found = true
+				break
+			}
+		}
+		if !found {
+			groups = append(groups, []deckCard{card})
+		}
+		byCount[card.count] = groups
+	}
+
+	b := []byte{byte(deckCodeFormat<<4 | version)}
+	for count := 3; count > 0; count-- {
+		groups := byCount[count]
+		sort.SliceStable(groups, func(i, j int) bool { return len(groups[i]) < len(groups[j]) })
+		b = appendUvarint(b, uint64(len(groups)))
+		for _, g := range groups {
+			sort.Slice(g, func(i, j int) bool { return g[i].code < g[j].code })
+			b = appendUvarint(b, uint64(len(g)))
+			b = appendUvarint(b, g[0].set)
+			b = appendUvarint(b, g[0].faction)
+			for _, card := range g {
+				b = appendUvarint(b, card.number)
+			}
+		}
+	}
+	sort.Slice(many, func(i, j int) bool { return many[i].code < many[j].code })
+	for _, card := range many {
+		b = appendUvarint(b, uint64(card.count))
+		b = appendUvarint(b, card.set)
+		b = appendUvarint(b, card.faction)
+		b = appendUvarint(b, card.number)
+	}
+	return deckCodeEncoding.EncodeToString(b), nil
+}
+
+// Deck decodes the player's deck code
+func (p *LORMatchPlayerDTO) Deck() (Deck, error) {
+	return DecodeDeck(p.DeckCode)
+}
+
+// Count returns the total number of cards in the deck
+func (d Deck) Count() int {
+	n := 0
+	for _, c := range d {
+		n += c.Count
+	}
+	return n
+}
+
+func deckCardCode(set, faction, number uint64) (string, error) {
+	f, ok := deckFactions[faction]
+	if !ok {
+		return "", fmt.Errorf("lol: unknown deck code faction %d", faction)
+	}
+	return fmt.Sprintf("%02d%s%03d", set, f.code, number), nil
+}
+
+func parseDeckCard(c CardCodeAndCount) (deckCard, error) {
+	card := deckCard{count: c.Count, code: c.CardCode}
+	if c.Count < 1 {
+		return card, fmt.Errorf("lol: card %s has count %d", c.CardCode, c.Count)
+	}
+	if len(c.CardCode) != 7 {
+		return card, fmt.Errorf("lol: invalid card code %q", c.CardCode)
+	}
+	set, err := strconv.ParseUint(c.CardCode[:2], 10, 64)
+	if err != nil {
+		return card, fmt.Errorf("lol: invalid card code %q", c.CardCode)
+	}
+	number, err := strconv.ParseUint(c.CardCode[4:], 10, 64)
+	if err != nil {
+		return card, fmt.Errorf("lol: invalid card code %q", c.CardCode)
+	}
+	card.set, card.number = set, number
+	for id, f := range deckFactions {
+		if f.code == c.CardCode[2:4] {
+			card.faction = id
+			return card, nil
+		}
+	}
+	return card, fmt.Errorf("lol: unknown faction in card code %q", c.CardCode)
+}
+
+func appendUvarint(b []byte, v uint64) []byte {
+	var buf [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(buf[:], v)
+	return append(b, buf[:n]...)
+}
+
+// varintReader reads consecutive varints, keeping the first error so callers can check once
+type varintReader struct {
+	b   []byte
+	err error
+}
+
+func (r *varintReader) next() uint64 {
+	if r.err != nil {
+		return 0
+	}
+	v, n := binary.Uvarint(r.b)
+	if n <= 0 {
+		r.err = ErrInvalidDeckCode
+		return 0
+	}
+	r.b = r.b[n:]
+	return v
+}
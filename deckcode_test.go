@@ -0,0 +1,141 @@
+package lol
+
+import (
+	"encoding/base32"
+	"reflect"
+	"testing"
+)
+
+var deckCodeTests = []struct {
+	code string
+	deck Deck
+}{
+	{
+		"CEBAIAIFB4WDANQIAEAQGDAUDAQSIJZUAIAQCBIFAEAQCBAA",
+		Deck{
+			{"01SI015", 3}, {"01SI044", 3}, {"01SI048", 3}, {"01SI054", 3},
+			{"01FR003", 3}, {"01FR012", 3}, {"01FR020", 3}, {"01FR024", 3},
+			{"01FR033", 3}, {"01FR036", 3}, {"01FR039", 3}, {"01FR052", 3},
+			{"01SI005", 2}, {"01FR004", 2},
+		},
+	},
+	{
+		"CEAAECABAQJRWHBIFU2DOOYIAEBAMCIMCINCILJZAICACBANE4VCYBABAILR2HRL",
+		Deck{
+			{"01PZ019", 2}, {"01PZ027", 2}, {"01PZ028", 2}, {"01PZ040", 2},
+			{"01PZ045", 2}, {"01PZ052", 2}, {"01PZ055", 2}, {"01PZ059", 2},
+			{"01IO006", 2}, {"01IO009", 2}, {"01IO012", 2}, {"01IO018", 2},
+			{"01IO026", 2}, {"01IO036", 2}, {"01IO045", 2}, {"01IO057", 2},
+			{"01PZ013", 1}, {"01PZ039", 1}, {"01PZ042", 1}, {"01PZ044", 1},
+			{"01IO023", 1}, {"01IO029", 1}, {"01IO030", 1}, {"01IO043", 1},
+		},
+	},
+}
+
+func TestDecodeDeck(t *testing.T) {
+	for _, test := range deckCodeTests {
+		actual, err := DecodeDeck(test.code)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if !reflect.DeepEqual(test.deck, actual) {
+			t.Errorf("\nExpected: %v\nActual: %v\n", test.deck, actual)
+			return
+		}
+		if actual.Count() != 40 {
+			t.Errorf("\nExpected: 40\nActual: %d\n", actual.Count())
+			return
+		}
+	}
+}
+
+func TestEncodeDeck(t *testing.T) {
+	for _, test := range deckCodeTests {
+		actual, err := EncodeDeck(test.deck)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if test.code != actual {
+			t.Errorf("\nExpected: %s\nActual: %s\n", test.code, actual)
+			return
+		}
+	}
+}
+
+// deckCodeVersionTests hold a deck for every version after 1, encoded by hand following the
+// format spec so they do not depend on EncodeDeck
+var deckCodeVersionTests = []struct {
+	code    string
+	deck    Deck
+	version byte
+}{
+	{
+		"CIBACAIAAEBAEBQ2FYBACAQGGUAQCAAMAA",
+		Deck{{"01DE001", 3}, {"02BW026", 3}, {"02BW046", 3}, {"02BW053", 2}, {"01DE012", 2}},
+		2,
+	},
+	// Mount Targon alone only needs version 2
+	{
+		"CIAQEAYJAMRQCAIDBE3ACAIDBFLQ",
+		Deck{{"03MT003", 3}, {"03MT035", 3}, {"03MT054", 2}, {"03MT087", 1}},
+		2,
+	},
+	{
+		"CMBACBAHF4AQCAQJAEAQIB2JAEAQGCID",
+		Deck{{"04SH047", 3}, {"01IO009", 3}, {"04SH073", 2}, {"03MT003", 1}},
+		3,
+	},
+	{
+		"CQAQCBAKBMAQCBAKFAAQCBAHF4",
+		Deck{{"04BC011", 3}, {"04BC040", 2}, {"04SH047", 1}},
+		4,
+	},
+	{
+		"CUAACAIEBIFQCAIFBQGAIAIAAIDACAQJ",
+		Deck{{"04BC011", 2}, {"05RU012", 1}, {"01DE002", 4}, {"01IO009", 6}},
+		5,
+	},
+}
+
+func TestDeckCodeVersions(t *testing.T) {
+	for _, test := range deckCodeVersionTests {
+		deck, err := DecodeDeck(test.code)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if !reflect.DeepEqual(test.deck, deck) {
+			t.Errorf("\nExpected: %v\nActual: %v\n", test.deck, deck)
+			return
+		}
+		code, err := EncodeDeck(test.deck)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if test.code != code {
+			t.Errorf("\nExpected: %s\nActual: %s\n", test.code, code)
+			return
+		}
+		b, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(code)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if expected, actual := 0x10|test.version, b[0]; expected != actual {
+			t.Errorf("\nExpected: %#x\nActual: %#x\n", expected, actual)
+			return
+		}
+	}
+}
+
+func TestDecodeDeckInvalid(t *testing.T) {
+	for _, code := range []string{"", "not a deck code", "CEBAIAIFB4WDANQ", "GEBAIAIFB4WDANQIAEAQGDAUDAQSIJZUAIAQCBIFAEAQCBAA"} {
+		if _, err := DecodeDeck(code); err == nil {
+			t.Errorf("\nExpected: error for %q\nActual: nil\n", code)
+			return
+		}
+	}
+}
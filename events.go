@@ -0,0 +1,352 @@
+package lol
+
+import (
+	"time"
+)
+
+// Timeline event types as found in MatchEventDTO.Type
+const (
+	EventTypeChampionKill     = "CHAMPION_KILL"
+	EventTypeWardPlaced       = "WARD_PLACED"
+	EventTypeWardKill         = "WARD_KILL"
+	EventTypeBuildingKill     = "BUILDING_KILL"
+	EventTypeEliteMonsterKill = "ELITE_MONSTER_KILL"
+	EventTypeItemPurchased    = "ITEM_PURCHASED"
+	EventTypeItemSold         = "ITEM_SOLD"
+	EventTypeItemDestroyed    = "ITEM_DESTROYED"
+	EventTypeItemUndo         = "ITEM_UNDO"
+	EventTypeSkillLevelUp     = "SKILL_LEVEL_UP"
+	EventTypeAscendedEvent    = "ASCENDED_EVENT"
+	EventTypeCapturePoint     = "CAPTURE_POINT"
+	EventTypePoroKingSummon   = "PORO_KING_SUMMON"
+)
+
+// SkillLevelUp level up types, evolutions are e.g. Kha'Zix and Kai'Sa evolving an ability
+const (
+	LevelUpTypeNormal = "NORMAL"
+	LevelUpTypeEvolve = "EVOLVE"
+)
+
+// Event is a decoded timeline event, switch on its concrete type to read it:
+//
+//	switch e := event.(type) {
+//	case lol.ChampionKill:
+//		// e.KillerID, e.VictimID ...
+//	case lol.BuildingKill:
+//		// e.LaneType, e.TowerType ...
+//	}
+type Event interface {
+	// Type returns the event type, one of the EventType constants
+	Type() string
+	// Time returns when the event happened since the game started
+	Time() time.Duration
+	// Team returns the team credited with the event, 0 when no team is
+	Team() int
+	// ParticipantIDs returns every participant taking part in the event
+	ParticipantIDs() []int
+}
+
+// Events is a list of timeline events, oldest first
+type Events []Event
+
+// BaseEvent holds what every event has, it is embedded in the event types
+type BaseEvent struct {
+	// Timestamp is milliseconds since the game started
+	Timestamp int64
+}
+
+// Time returns Timestamp as a duration
+func (e BaseEvent) Time() time.Duration {
+	return time.Duration(e.Timestamp) * time.Millisecond
+}
+
+type ChampionKill struct {
+	BaseEvent
+	// KillerID is 0 when the victim was executed by a minion, tower or monster
+	KillerID                int
+	VictimID                int
+	AssistingParticipantIDs []int
+	Position                MatchPositionDTO
+}
+
+type WardPlaced struct {
+	BaseEvent
+	CreatorID int
+	WardType  string
+}
+
+type WardKill struct {
+	BaseEvent
+	KillerID int
+	WardType string
+}
+
+type BuildingKill struct {
+	BaseEvent
+	KillerID                int
+	AssistingParticipantIDs []int
+	// TeamID is the team that lost the building
+	TeamID       int
+	BuildingType string
+	LaneType     string
+	TowerType    string
+	Position     MatchPositionDTO
+}
+
+type EliteMonsterKill struct {
+	BaseEvent
+	KillerID       int
+	MonsterType    string
+	MonsterSubType string
+	Position       MatchPositionDTO
+}
+
+type ItemPurchased struct {
+	BaseEvent
+	ParticipantID int
+	ItemID        int
+}
+
+type ItemSold struct {
+	BaseEvent
+	ParticipantID int
+	ItemID        int
+}
+
+type ItemDestroyed struct {
+	BaseEvent
+	ParticipantID int
+	ItemID        int
+}
+
+// ItemUndo reverts a purchase when BeforeID is set and a sale when AfterID is set
+type ItemUndo struct {
+	BaseEvent
+	ParticipantID int
+	BeforeID      int
+	AfterID       int
+}
+
+type SkillLevelUp struct {
+	BaseEvent
+	ParticipantID int
+	// SkillSlot is 1 to 4 for Q, W, E and R
+	SkillSlot int
+	// LevelUpType is one of the LevelUpType constants
+	LevelUpType string
+}
+
+// UnknownEvent keeps events of the rarer modes, e.g. ASCENDED_EVENT, as they were received
+type UnknownEvent struct {
+	MatchEventDTO
+}
+
+// DecodeEvent returns the typed event for dto
+func DecodeEvent(dto MatchEventDTO) Event {
+	base := BaseEvent{Timestamp: dto.Timestamp}
+	switch dto.Type {
+	case EventTypeChampionKill:
+		return ChampionKill{base, dto.KillerID, dto.VictimID, dto.AssistingParticipantIDs, dto.Position}
+	case EventTypeWardPlaced:
+		return WardPlaced{base, dto.CreatorID, dto.WardType}
+	case EventTypeWardKill:
+		return WardKill{base, dto.KillerID, dto.WardType}
+	case EventTypeBuildingKill:
+		return BuildingKill{base, dto.KillerID, dto.AssistingParticipantIDs, dto.TeamID, dto.BuildingType, dto.LaneType, dto.TowerType, dto.Position}
+	case EventTypeEliteMonsterKill:
+		return EliteMonsterKill{base, dto.KillerID, dto.MonsterType, dto.MonsterSubType, dto.Position}
+	case EventTypeItemPurchased:
+		return ItemPurchased{base, dto.ParticipantID, dto.ItemID}
+	case EventTypeItemSold:
+		return ItemSold{base, dto.ParticipantID, dto.ItemID}
+	case EventTypeItemDestroyed:
+		return ItemDestroyed{base, dto.ParticipantID, dto.ItemID}
+	case EventTypeItemUndo:
+		return ItemUndo{base, dto.ParticipantID, dto.BeforeID, dto.AfterID}
+	case EventTypeSkillLevelUp:
+		return SkillLevelUp{base, dto.ParticipantID, dto.SkillSlot, dto.LevelUpType}
+	default:
+		return UnknownEvent{dto}
+	}
+}
+
+// Events decodes the events of every frame, oldest first
+func (t *MatchTimelineDTO) Events() Events {
+	var events Events
+	for _, f := range t.Frames {
+		for _, e := range f.Events {
+			events = append(events, DecodeEvent(e))
+		}
+	}
+	return events
+}
+
+// ByParticipant returns the events participantID took part in
+func (events Events) ByParticipant(participantID int) Events {
+	return events.Filter(func(e Event) bool {
+		for _, id := range e.ParticipantIDs() {
+			if id == participantID {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// ByTeam returns the events teamID is credited with
+func (events Events) ByTeam(teamID int) Events {
+	return events.Filter(func(e Event) bool { return e.Team() == teamID })
+}
+
+// ByType returns the events of eventType, one of the EventType constants
+func (events Events) ByType(eventType string) Events {
+	return events.Filter(func(e Event) bool { return e.Type() == eventType })
+}
+
+// Kills returns the champion kills participantID got
+func (events Events) Kills(participantID int) Events {
+	return events.Filter(func(e Event) bool {
+		k, ok := e.(ChampionKill)
+		return ok && k.KillerID == participantID
+	})
+}
+
+// Deaths returns the champion kills participantID died in
+func (events Events) Deaths(participantID int) Events {
+	return events.Filter(func(e Event) bool {
+		k, ok := e.(ChampionKill)
+		return ok && k.VictimID == participantID
+	})
+}
+
+// Between returns the events from from up to but excluding to
+func (events Events) Between(from, to time.Duration) Events {
+	return events.Filter(func(e Event) bool { return e.Time() >= from && e.Time() < to })
+}
+
+// Filter returns the events keep returns true for
+func (events Events) Filter(keep func(Event) bool) Events {
+	var filtered Events
+	for _, e := range events {
+		if keep(e) {
+			filtered = append(filtered, e)
+		}
+	}
+	return filtered
+}
+
+func (ChampionKill) Type() string { return EventTypeChampionKill }
+
+// Team returns the killer's team, or the victim's enemies when executed
+func (e ChampionKill) Team() int {
+	if e.KillerID == 0 {
+		return enemyTeam(participantTeam(e.VictimID))
+	}
+	return participantTeam(e.KillerID)
+}
+
+func (e ChampionKill) ParticipantIDs() []int {
+	return participantIDs(append([]int{e.KillerID, e.VictimID}, e.AssistingParticipantIDs...)...)
+}
+
+func (WardPlaced) Type() string { return EventTypeWardPlaced }
+
+func (e WardPlaced) Team() int { return participantTeam(e.CreatorID) }
+
+func (e WardPlaced) ParticipantIDs() []int { return participantIDs(e.CreatorID) }
+
+func (WardKill) Type() string { return EventTypeWardKill }
+
+func (e WardKill) Team() int { return participantTeam(e.KillerID) }
+
+func (e WardKill) ParticipantIDs() []int { return participantIDs(e.KillerID) }
+
+func (BuildingKill) Type() string { return EventTypeBuildingKill }
+
+// Team returns the team that destroyed the building
+func (e BuildingKill) Team() int { return enemyTeam(e.TeamID) }
+
+func (e BuildingKill) ParticipantIDs() []int {
+	return participantIDs(append([]int{e.KillerID}, e.AssistingParticipantIDs...)...)
+}
+
+func (EliteMonsterKill) Type() string { return EventTypeEliteMonsterKill }
+
+func (e EliteMonsterKill) Team() int { return participantTeam(e.KillerID) }
+
+func (e EliteMonsterKill) ParticipantIDs() []int { return participantIDs(e.KillerID) }
+
+func (ItemPurchased) Type() string { return EventTypeItemPurchased }
+
+func (e ItemPurchased) Team() int { return participantTeam(e.ParticipantID) }
+
+func (e ItemPurchased) ParticipantIDs() []int { return participantIDs(e.ParticipantID) }
+
+func (ItemSold) Type() string { return EventTypeItemSold }
+
+func (e ItemSold) Team() int { return participantTeam(e.ParticipantID) }
+
+func (e ItemSold) ParticipantIDs() []int { return participantIDs(e.ParticipantID) }
+
+func (ItemDestroyed) Type() string { return EventTypeItemDestroyed }
+
+func (e ItemDestroyed) Team() int { return participantTeam(e.ParticipantID) }
+
+func (e ItemDestroyed) ParticipantIDs() []int { return participantIDs(e.ParticipantID) }
+
+func (ItemUndo) Type() string { return EventTypeItemUndo }
+
+func (e ItemUndo) Team() int { return participantTeam(e.ParticipantID) }
+
+func (e ItemUndo) ParticipantIDs() []int { return participantIDs(e.ParticipantID) }
+
+func (SkillLevelUp) Type() string { return EventTypeSkillLevelUp }
+
+func (e SkillLevelUp) Team() int { return participantTeam(e.ParticipantID) }
+
+func (e SkillLevelUp) ParticipantIDs() []int { return participantIDs(e.ParticipantID) }
+
+func (e UnknownEvent) Type() string { return e.MatchEventDTO.Type }
+
+// Time returns Timestamp as a duration
+func (e UnknownEvent) Time() time.Duration { return time.Duration(e.Timestamp) * time.Millisecond }
+
+func (e UnknownEvent) Team() int {
+	if e.TeamID != 0 {
+		return e.TeamID
+	}
+	return participantTeam(e.firstParticipant())
+}
+
+func (e UnknownEvent) ParticipantIDs() []int {
+	return participantIDs(append([]int{e.ParticipantID, e.KillerID, e.VictimID, e.CreatorID}, e.AssistingParticipantIDs...)...)
+}
+
+func (e UnknownEvent) firstParticipant() int {
+	if ids := e.ParticipantIDs(); len(ids) > 0 {
+		return ids[0]
+	}
+	return 0
+}
+
+func enemyTeam(teamID int) int {
+	switch teamID {
+	case 100:
+		return 200
+	case 200:
+		return 100
+	default:
+		return 0
+	}
+}
+
+// participantIDs drops the zero IDs the API uses for minions, towers and monsters
+func participantIDs(ids ...int) []int {
+	var valid []int
+	for _, id := range ids {
+		if id > 0 {
+			valid = append(valid, id)
+		}
+	}
+	return valid
+}
@@ -0,0 +1,104 @@
+package lol
+
+import (
+	"log"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/dnaeon/go-vcr/recorder"
+)
+
+func TestTimelineEvents(t *testing.T) {
+	rec, err := recorder.New("cassettes/lol/match-v4/timelines")
+	if err != nil {
+		log.Fatal(err)
+	} else {
+		rec.SetMatcher(matchWithoutToken)
+		httpClient = &http.Client{Transport: rec}
+	}
+	rec.AddFilter(removeToken)
+	defer rec.Stop()
+	cli, err := NewClient(testToken, WithHTTPClient(httpClient))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	tl, resp, err := cli.Timelines(matchID)
+	if resp.StatusCode != 200 {
+		t.Errorf("\nExpected: 200 status code\nActual: %d status code", resp.StatusCode)
+		return
+	}
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	events := tl.Events()
+	tests := []struct {
+		name     string
+		expected int
+		actual   int
+	}{
+		{"events", 952, len(events)},
+		{"champion kills", 99, len(events.ByType(EventTypeChampionKill))},
+		{"team 100 champion kills", 55, len(events.ByTeam(100).ByType(EventTypeChampionKill))},
+		{"team 100 building kills", 11, len(events.ByTeam(100).ByType(EventTypeBuildingKill))},
+		{"participant 5 events", 111, len(events.ByParticipant(5))},
+		{"participant 5 kills", 22, len(events.Kills(5))},
+		{"participant 5 deaths", 7, len(events.Deaths(5))},
+		{"events from 5 to 10 minutes", 168, len(events.Between(5*time.Minute, 10*time.Minute))},
+	}
+	for _, test := range tests {
+		if test.expected != test.actual {
+			t.Errorf("\n%s\nExpected: %d\nActual: %d\n", test.name, test.expected, test.actual)
+			return
+		}
+	}
+
+	switch e := events.ByType(EventTypeChampionKill)[0].(type) {
+	case ChampionKill:
+		if e.KillerID != 5 || e.VictimID != 9 || e.Time() != 150497*time.Millisecond || e.Position.X != 13677 {
+			t.Errorf("\nExpected: participant 5 kills 9 at 150497ms\nActual: %+v\n", e)
+			return
+		}
+	default:
+		t.Errorf("\nExpected: ChampionKill\nActual: %T\n", e)
+		return
+	}
+	if e, ok := events.ByType(EventTypeEliteMonsterKill)[0].(EliteMonsterKill); !ok || e.MonsterSubType != "AIR_DRAGON" || e.Team() != 100 {
+		t.Errorf("\nExpected: team 100 kills an air dragon\nActual: %+v\n", e)
+	}
+}
+
+func TestDecodeUnknownEvent(t *testing.T) {
+	e := DecodeEvent(MatchEventDTO{Type: EventTypePoroKingSummon, Timestamp: 1000, TeamID: 200})
+	if _, ok := e.(UnknownEvent); !ok || e.Type() != EventTypePoroKingSummon || e.Team() != 200 || e.Time() != time.Second {
+		t.Errorf("\nExpected: team 200 PORO_KING_SUMMON at 1s\nActual: %+v\n", e)
+	}
+}
+
+func TestEventsKillsDeaths(t *testing.T) {
+	events := Events{
+		ChampionKill{KillerID: 1, VictimID: 6},
+		ChampionKill{KillerID: 6, VictimID: 1},
+		ChampionKill{KillerID: 1, VictimID: 7},
+		WardPlaced{CreatorID: 1},
+	}
+	tests := []struct {
+		name     string
+		expected int
+		actual   int
+	}{
+		{"participant 1 kills", 2, len(events.Kills(1))},
+		{"participant 1 deaths", 1, len(events.Deaths(1))},
+		{"participant 7 kills", 0, len(events.Kills(7))},
+		{"participant 7 deaths", 1, len(events.Deaths(7))},
+	}
+	for _, test := range tests {
+		if test.expected != test.actual {
+			t.Errorf("\n%s\nExpected: %d\nActual: %d\n", test.name, test.expected, test.actual)
+			return
+		}
+	}
+}
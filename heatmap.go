@@ -0,0 +1,261 @@
+package lol
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"io"
+	"time"
+)
+
+// DefaultHeatmapImageSize is the width and height of heatmaps rendered without a background
+const DefaultHeatmapImageSize = 512
+
+// MapBounds is the coordinate range of a map, positions are in game units with y growing north
+type MapBounds struct {
+	MinX int
+	MinY int
+	MaxX int
+	MaxY int
+}
+
+// MapBoundsByID holds the coordinate range of the maps Riot documents, keyed by map ID
+var MapBoundsByID = map[int]MapBounds{
+	MapIDTwistedTreeline: {MinX: 0, MinY: 0, MaxX: 15398, MaxY: 15398},
+	MapIDSummonersRift:   {MinX: -120, MinY: -120, MaxX: 14870, MaxY: 14980},
+	MapIDHowlingAbyss:    {MinX: -28, MinY: -19, MaxX: 12849, MaxY: 12858},
+}
+
+// Heatmap counts positions on a grid laid over a map. Row 0 is the north edge of the map so
+// the grid reads like the minimap.
+type Heatmap struct {
+	MapID   int
+	Columns int
+	Rows    int
+	bounds  MapBounds
+	cells   []int
+}
+
+// NewHeatmap returns an empty heatmap of columns by rows cells over mapID
+func NewHeatmap(mapID, columns, rows int) (*Heatmap, error) {
+	bounds, ok := MapBoundsByID[mapID]
+	if !ok {
+		return nil, fmt.Errorf("lol: bounds of map %d are unknown", mapID)
+	}
+	if columns <= 0 || rows <= 0 {
+		return nil, fmt.Errorf("lol: invalid heatmap grid %dx%d", columns, rows)
+	}
+	return &Heatmap{MapID: mapID, Columns: columns, Rows: rows, bounds: bounds, cells: make([]int, columns*rows)}, nil
+}
+
+// Normalize maps p into [0, 1] on both axes, positions outside the bounds are clamped
+func (b MapBounds) Normalize(p MatchPositionDTO) (x, y float64) {
+	return clamp01(float64(p.X-b.MinX) / float64(b.MaxX-b.MinX)), clamp01(float64(p.Y-b.MinY) / float64(b.MaxY-b.MinY))
+}
+
+// NormalizePosition maps p on mapID into [0, 1], false when the map bounds are unknown
+func NormalizePosition(mapID int, p MatchPositionDTO) (x, y float64, ok bool) {
+	bounds, ok := MapBoundsByID[mapID]
+	if !ok {
+		return 0, 0, false
+	}
+	x, y = bounds.Normalize(p)
+	return x, y, true
+}
+
+// Add counts p in the cell it falls in
+func (h *Heatmap) Add(p MatchPositionDTO) {
+	x, y := h.bounds.Normalize(p)
+	column := cellIndex(x, h.Columns)
+	row := cellIndex(1-y, h.Rows)
+	h.cells[row*h.Columns+column]++
+}
+
+// AddFrames counts the position of participantIDs at every frame of t, every participant
+// when none are given. See MatchDTO.TeamParticipantIDs and MatchDTO.RoleParticipantIDs.
+func (h *Heatmap) AddFrames(t *MatchTimelineDTO, participantIDs ...int) {
+	for _, f := range t.Frames {
+		for _, pf := range f.ParticipantFrames {
+			if len(participantIDs) == 0 || containsInt(participantIDs, pf.ParticipantID) {
+				h.Add(pf.Position)
+			}
+		}
+	}
+}
+
+// AddEvents counts the position of events, see MatchTimelineDTO.EventPosition.
+// Events without a position, e.g. item purchases, are skipped.
+func (h *Heatmap) AddEvents(t *MatchTimelineDTO, events Events) {
+	for _, e := range events {
+		if p, ok := t.EventPosition(e); ok {
+			h.Add(p)
+		}
+	}
+}
+
+// Cell returns the count of the cell at column and row
+func (h *Heatmap) Cell(column, row int) int {
+	return h.cells[row*h.Columns+column]
+}
+
+// Max returns the highest cell count
+func (h *Heatmap) Max() int {
+	max := 0
+	for _, c := range h.cells {
+		if c > max {
+			max = c
+		}
+	}
+	return max
+}
+
+// Total returns how many positions were counted
+func (h *Heatmap) Total() int {
+	total := 0
+	for _, c := range h.cells {
+		total += c
+	}
+	return total
+}
+
+// Render draws the heatmap over background, which is expected to show the whole map like the
+// Data Dragon map images do. Without background a transparent DefaultHeatmapImageSize square is used.
+func (h *Heatmap) Render(background image.Image) *image.RGBA {
+	var bounds image.Rectangle
+	if background != nil {
+		bounds = background.Bounds()
+	} else {
+		bounds = image.Rect(0, 0, DefaultHeatmapImageSize, DefaultHeatmapImageSize)
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	if background != nil {
+		draw.Draw(dst, dst.Bounds(), background, bounds.Min, draw.Src)
+	}
+
+	max := h.Max()
+	if max == 0 || dst.Bounds().Empty() {
+		return dst
+	}
+	overlay := image.NewNRGBA(dst.Bounds())
+	for py := 0; py < dst.Bounds().Dy(); py++ {
+		row := py * h.Rows / dst.Bounds().Dy()
+		for px := 0; px < dst.Bounds().Dx(); px++ {
+			column := px * h.Columns / dst.Bounds().Dx()
+			if c := h.Cell(column, row); c > 0 {
+				overlay.SetNRGBA(px, py, heatColor(float64(c)/float64(max)))
+			}
+		}
+	}
+	draw.Draw(dst, dst.Bounds(), overlay, image.Point{}, draw.Over)
+	return dst
+}
+
+// WritePNG renders the heatmap over background, see Render, and encodes it as PNG to w
+func (h *Heatmap) WritePNG(w io.Writer, background image.Image) error {
+	return png.Encode(w, h.Render(background))
+}
+
+// EventPosition returns where e happened. Kills carry their position, WARD_PLACED and WARD_KILL
+// do not so the position of the participant at the frame before the event is used instead.
+func (t *MatchTimelineDTO) EventPosition(e Event) (MatchPositionDTO, bool) {
+	switch e := e.(type) {
+	case ChampionKill:
+		return e.Position, true
+	case BuildingKill:
+		return e.Position, true
+	case EliteMonsterKill:
+		return e.Position, true
+	case WardPlaced:
+		return t.positionAt(e.CreatorID, e.Time())
+	case WardKill:
+		return t.positionAt(e.KillerID, e.Time())
+	}
+	return MatchPositionDTO{}, false
+}
+
+// TeamParticipantIDs returns the participants playing for teamID
+func (m *MatchDTO) TeamParticipantIDs(teamID int) []int {
+	var ids []int
+	for _, p := range m.Participants {
+		if p.TeamID == teamID {
+			ids = append(ids, p.ParticipantID)
+		}
+	}
+	return ids
+}
+
+// RoleParticipantIDs returns the participants Riot assigned to lane and role, e.g. BOTTOM and
+// DUO_SUPPORT. An empty role matches any role of the lane.
+func (m *MatchDTO) RoleParticipantIDs(lane, role string) []int {
+	var ids []int
+	for _, p := range m.Participants {
+		if p.Timeline.Lane == lane && (role == "" || p.Timeline.Role == role) {
+			ids = append(ids, p.ParticipantID)
+		}
+	}
+	return ids
+}
+
+func (t *MatchTimelineDTO) positionAt(participantID int, at time.Duration) (MatchPositionDTO, bool) {
+	ts := int(at / time.Millisecond)
+	var position MatchPositionDTO
+	found := false
+	for _, f := range t.Frames {
+		if f.Timestamp > ts {
+			break
+		}
+		if pf, ok := f.participantFrame(participantID); ok {
+			position, found = pf.Position, true
+		}
+	}
+	return position, found
+}
+
+// heatColor ramps from translucent blue through green and yellow to opaque red as v goes to 1
+func heatColor(v float64) color.NRGBA {
+	stops := []color.NRGBA{
+		{0, 0, 255, 96},
+		{0, 255, 0, 144},
+		{255, 255, 0, 192},
+		{255, 0, 0, 224},
+	}
+	pos := clamp01(v) * float64(len(stops)-1)
+	i := int(pos)
+	if i >= len(stops)-1 {
+		return stops[len(stops)-1]
+	}
+	f := pos - float64(i)
+	a, b := stops[i], stops[i+1]
+	mix := func(x, y uint8) uint8 { return uint8(float64(x) + (float64(y)-float64(x))*f) }
+	return color.NRGBA{mix(a.R, b.R), mix(a.G, b.G), mix(a.B, b.B), mix(a.A, b.A)}
+}
+
+// cellIndex returns the cell v in [0, 1] falls in, 1 itself belongs to the last cell
+func cellIndex(v float64, cells int) int {
+	i := int(v * float64(cells))
+	if i >= cells {
+		i = cells - 1
+	}
+	return i
+}
+
+func clamp01(v float64) float64 {
+	switch {
+	case v < 0:
+		return 0
+	case v > 1:
+		return 1
+	}
+	return v
+}
+
+func containsInt(ids []int, id int) bool {
+	for _, i := range ids {
+		if i == id {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,128 @@
+package lol
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"log"
+	"net/http"
+	"testing"
+
+	"github.com/dnaeon/go-vcr/recorder"
+)
+
+func TestNormalizePosition(t *testing.T) {
+	tests := []struct {
+		position MatchPositionDTO
+		x, y     float64
+	}{
+		{MatchPositionDTO{X: -120, Y: -120}, 0, 0},
+		{MatchPositionDTO{X: 14870, Y: 14980}, 1, 1},
+		{MatchPositionDTO{X: 20000, Y: -500}, 1, 0},
+	}
+	for _, test := range tests {
+		x, y, ok := NormalizePosition(MapIDSummonersRift, test.position)
+		if !ok || test.x != x || test.y != y {
+			t.Errorf("\nExpected: %f, %f\nActual: %f, %f\n", test.x, test.y, x, y)
+			return
+		}
+	}
+	if _, _, ok := NormalizePosition(99, MatchPositionDTO{}); ok {
+		t.Error("\nExpected: unknown map\nActual: bounds found")
+		return
+	}
+	if _, err := NewHeatmap(99, 16, 16); err == nil {
+		t.Error("\nExpected: error for unknown map\nActual: nil")
+	}
+}
+
+func TestHeatmap(t *testing.T) {
+	rec, err := recorder.New("cassettes/lol/match-v4/timelines")
+	if err != nil {
+		log.Fatal(err)
+	} else {
+		rec.SetMatcher(matchWithoutToken)
+		httpClient = &http.Client{Transport: rec}
+	}
+	rec.AddFilter(removeToken)
+	defer rec.Stop()
+	cli, err := NewClient(testToken, WithHTTPClient(httpClient))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	tl, resp, err := cli.Timelines(matchID)
+	if resp.StatusCode != 200 {
+		t.Errorf("\nExpected: 200 status code\nActual: %d status code", resp.StatusCode)
+		return
+	}
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	events := tl.Events()
+	tests := []struct {
+		name     string
+		fill     func(h *Heatmap)
+		expected int
+	}{
+		{"participant 1 frames", func(h *Heatmap) { h.AddFrames(tl, 1) }, 31},
+		{"all frames", func(h *Heatmap) { h.AddFrames(tl) }, 310},
+		{"participant 1 deaths", func(h *Heatmap) { h.AddEvents(tl, events.Deaths(1)) }, 11},
+		// wards placed without a creator have no position to fall back on
+		{"wards placed", func(h *Heatmap) { h.AddEvents(tl, events.ByType(EventTypeWardPlaced)) }, 197},
+		{"item purchases", func(h *Heatmap) { h.AddEvents(tl, events.ByType(EventTypeItemPurchased)) }, 0},
+	}
+	for _, test := range tests {
+		h, err := NewHeatmap(MapIDSummonersRift, 16, 16)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		test.fill(h)
+		if actual := h.Total(); test.expected != actual {
+			t.Errorf("\n%s\nExpected: %d\nActual: %d\n", test.name, test.expected, actual)
+			return
+		}
+	}
+
+	h, err := NewHeatmap(MapIDSummonersRift, 16, 16)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	h.AddFrames(tl, 1)
+	// every participant starts the game in the fountain, bottom left for team 100
+	if h.Cell(0, 15) == 0 {
+		t.Error("\nExpected: positions in the blue fountain\nActual: none")
+		return
+	}
+
+	gray := color.RGBA{128, 128, 128, 255}
+	background := image.NewRGBA(image.Rect(0, 0, 32, 32))
+	draw.Draw(background, background.Bounds(), &image.Uniform{C: gray}, image.Point{}, draw.Src)
+	var buf bytes.Buffer
+	if err := h.WritePNG(&buf, background); err != nil {
+		t.Error(err)
+		return
+	}
+	img, err := png.Decode(&buf)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if expected, actual := background.Bounds(), img.Bounds(); expected != actual {
+		t.Errorf("\nExpected: %v\nActual: %v\n", expected, actual)
+		return
+	}
+	if color.RGBAModel.Convert(img.At(0, 31)) == gray {
+		t.Error("\nExpected: heat over the blue fountain\nActual: background")
+		return
+	}
+	if h.Cell(15, 0) == 0 && color.RGBAModel.Convert(img.At(31, 0)) != gray {
+		t.Error("\nExpected: background where participant 1 never was\nActual: heat")
+	}
+}
@@ -11,7 +11,8 @@ import (
 
 // LOL provides methods to interface with lol resource
 type LOL struct {
-	sling *sling.Sling
+	sling    *sling.Sling
+	regional *sling.Sling
 }
 
 type ChampionInfo struct {
@@ -67,6 +68,8 @@ type CurrentGameParticipant struct {
 	Spell1ID                 int64                     `json:"spell1Id"`
 	TeamID                   int64                     `json:"teamId"`
 	SummonerID               string                    `json:"summonerId"`
+	Puuid                    string                    `json:"puuid"`
+	RiotID                   string                    `json:"riotId"`
 }
 
 type Participant struct {
@@ -93,6 +96,8 @@ type Perks struct {
 
 type EntriesParams struct {
 	Page string `url:"page,omitempty"`
+	// Queue is only used by TFT.Entries, one of the TFTQueue constants
+	Queue string `url:"queue,omitempty"`
 }
 
 type FeaturedGames struct {
@@ -136,6 +141,10 @@ type LeagueEntryDTO struct {
 	LeagueID     string        `json:"leagueId"`
 	SummonerID   string        `json:"summonerId"`
 	LeaguePoints int           `json:"leaguePoints"`
+	Puuid        string        `json:"puuid"`
+	// RatedTier and RatedRating are only set for TFT rated queues like RANKED_TFT_TURBO
+	RatedTier   string `json:"ratedTier"`
+	RatedRating int    `json:"ratedRating"`
 }
 
 type LeagueExpEntriesParams struct {
@@ -419,13 +428,13 @@ type MatchlistDTO struct {
 }
 
 type MatchlistsParams struct {
-	Champion   []int `url:"champion"`
-	Queue      []int `url:"queue"`
-	Season     []int `url:"season"`
-	EndTime    int   `url:"endTime"`
-	BeginTime  int   `url:"beginTime"`
-	EndIndex   int   `url:"endIndex"`
-	BeginIndex int   `url:"beginIndex"`
+	Champion   []int   `url:"champion"`
+	Queue      []Queue `url:"queue"`
+	Season     []int   `url:"season"`
+	EndTime    int     `url:"endTime,omitempty"`
+	BeginTime  int     `url:"beginTime,omitempty"`
+	EndIndex   int     `url:"endIndex,omitempty"`
+	BeginIndex int     `url:"beginIndex,omitempty"`
 }
 
 type MatchReferenceDTO struct {
@@ -445,6 +454,7 @@ type Message struct {
 	CreatedAt    string        `json:"created_at"`
 	Translations []Translation `json:"translations"`
 	UpdatedAt    string        `json:"updated_at"`
+	Heading      string        `json:"heading"`
 	Content      string        `json:"content"`
 	ID           string        `json:"id"`
 }
@@ -484,20 +494,30 @@ type SummonerDTO struct {
 
 type Translation struct {
 	Locale    string `json:"locale"`
+	Heading   string `json:"heading"`
 	Content   string `json:"content"`
 	UpdatedAt string `json:"updated_at"`
 }
 
-// NewLOL returns a new LOL
+// NewLOL returns a new LOL, endpoints served by regional routing values go to the regional host
+// of the platform sling is based on. Use NewLOLWithRegion to pick the regional host.
 func NewLOL(sling *sling.Sling) *LOL {
-	return &LOL{sling: sling.New().Path("lol/")}
+	return NewLOLWithRegion(sling, routedSling(sling, regionalRoute))
+}
+
+// NewLOLWithRegion returns a new LOL, regional is used for endpoints served by regional routing values
+func NewLOLWithRegion(sling, regional *sling.Sling) *LOL {
+	return &LOL{
+		sling:    sling.New().Path("lol/"),
+		regional: regional.New().Path("lol/"),
+	}
 }
 
 // AllChampionMastery GET /lol/champion-mastery/v4/champion-masteries/by-summoner/{encryptedSummonerID}
 func (l *LOL) AllChampionMastery(encryptedSummonerID string) (*[]ChampionMasteryDTO, *http.Response, error) {
 	dtos := new([]ChampionMasteryDTO)
 	var reqErr error
-	resp, err := l.sling.Get("champion-mastery/v4/champion-masteries/by-summoner/"+encryptedSummonerID).Receive(dtos, reqErr)
+	resp, err := l.sling.New().Get("champion-mastery/v4/champion-masteries/by-summoner/"+encryptedSummonerID).Receive(dtos, reqErr)
 
 	if err != nil {
 		return nil, resp, err
@@ -510,7 +530,7 @@ func (l *LOL) AllChampionMastery(encryptedSummonerID string) (*[]ChampionMastery
 func (l *LOL) ChampionMastery(encryptedSummonerID, championID string) (*ChampionMasteryDTO, *http.Response, error) {
 	dto := new(ChampionMasteryDTO)
 	var reqErr error
-	resp, err := l.sling.Get("champion-mastery/v4/champion-masteries/by-summoner/"+encryptedSummonerID+"/by-champion/"+championID).Receive(dto, reqErr)
+	resp, err := l.sling.New().Get("champion-mastery/v4/champion-masteries/by-summoner/"+encryptedSummonerID+"/by-champion/"+championID).Receive(dto, reqErr)
 
 	if err != nil {
 		return nil, resp, err
@@ -521,7 +541,7 @@ func (l *LOL) ChampionMastery(encryptedSummonerID, championID string) (*Champion
 
 // MasteryScore GET /lol/champion-mastery/v4/scores/by-summoner/{encryptedSummonerID}
 func (l *LOL) MasteryScore(encryptedSummonerID string) (int, *http.Response, error) {
-	req, err := l.sling.Get("champion-mastery/v4/scores/by-summoner/" + encryptedSummonerID).Request()
+	req, err := l.sling.New().Get("champion-mastery/v4/scores/by-summoner/" + encryptedSummonerID).Request()
 	if err != nil {
 		return 0, nil, err
 	}
@@ -544,7 +564,7 @@ func (l *LOL) MasteryScore(encryptedSummonerID string) (int, *http.Response, err
 func (l *LOL) ChampionRotations() (*ChampionInfo, *http.Response, error) {
 	ci := new(ChampionInfo)
 	var reqErr error
-	resp, err := l.sling.Get("platform/v3/champion-rotations").Receive(ci, reqErr)
+	resp, err := l.sling.New().Get("platform/v3/champion-rotations").Receive(ci, reqErr)
 
 	if err != nil {
 		return nil, resp, err
@@ -553,12 +573,26 @@ func (l *LOL) ChampionRotations() (*ChampionInfo, *http.Response, error) {
 	return ci, resp, reqErr
 }
 
+// thirdPartyCodePath is shared by ThirdPartyCode and the polling in VerifyThirdPartyCode
+const thirdPartyCodePath = "platform/v4/third-party-code/by-summoner/"
+
+// ThirdPartyCode GET /lol/platform/v4/third-party-code/by-summoner/{encryptedSummonerID}
+func (l *LOL) ThirdPartyCode(encryptedSummonerID string) (string, *http.Response, error) {
+	code := new(string)
+	var reqErr error
+	resp, err := l.sling.New().Get(thirdPartyCodePath+encryptedSummonerID).Receive(code, reqErr)
+	if err != nil {
+		return "", resp, err
+	}
+	return *code, resp, reqErr
+}
+
 // LeagueExpEntries GET /lol/league-exp/v4/entries/{queue}/{tier}/{division}
 func (l *LOL) LeagueExpEntries(queue, tier, division string, params *LeagueExpEntriesParams) ([]LeagueEntryDTO, *http.Response, error) {
 	dtos := new([]LeagueEntryDTO)
 	var reqErr error
 	endpoint := fmt.Sprintf("league-exp/v4/entries/%s/%s/%s", queue, tier, division)
-	resp, err := l.sling.Get(endpoint).QueryStruct(params).Receive(dtos, reqErr)
+	resp, err := l.sling.New().Get(endpoint).QueryStruct(params).Receive(dtos, reqErr)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -569,7 +603,7 @@ func (l *LOL) LeagueExpEntries(queue, tier, division string, params *LeagueExpEn
 func (l *LOL) ChallengerLeagues(queue string) (*LeagueListDTO, *http.Response, error) {
 	dto := new(LeagueListDTO)
 	var reqErr error
-	resp, err := l.sling.Get("league/v4/challengerleagues/by-queue/"+queue).Receive(dto, reqErr)
+	resp, err := l.sling.New().Get("league/v4/challengerleagues/by-queue/"+queue).Receive(dto, reqErr)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -580,7 +614,7 @@ func (l *LOL) ChallengerLeagues(queue string) (*LeagueListDTO, *http.Response, e
 func (l *LOL) EntriesBySummoner(encryptedSummonerID string) ([]LeagueEntryDTO, *http.Response, error) {
 	dtos := new([]LeagueEntryDTO)
 	var reqErr error
-	resp, err := l.sling.Get("league/v4/entries/by-summoner/"+encryptedSummonerID).Receive(dtos, reqErr)
+	resp, err := l.sling.New().Get("league/v4/entries/by-summoner/"+encryptedSummonerID).Receive(dtos, reqErr)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -592,7 +626,7 @@ func (l *LOL) Entries(queue, tier, division string, params *EntriesParams) ([]Le
 	dtos := new([]LeagueEntryDTO)
 	var reqErr error
 	endpoint := fmt.Sprintf("league/v4/entries/%s/%s/%s", queue, tier, division)
-	resp, err := l.sling.Get(endpoint).QueryStruct(params).Receive(dtos, reqErr)
+	resp, err := l.sling.New().Get(endpoint).QueryStruct(params).Receive(dtos, reqErr)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -603,7 +637,7 @@ func (l *LOL) Entries(queue, tier, division string, params *EntriesParams) ([]Le
 func (l *LOL) GrandmasterLeagues(queue string) (*LeagueListDTO, *http.Response, error) {
 	dto := new(LeagueListDTO)
 	var reqErr error
-	resp, err := l.sling.Get("league/v4/grandmasterleagues/by-queue/"+queue).Receive(dto, reqErr)
+	resp, err := l.sling.New().Get("league/v4/grandmasterleagues/by-queue/"+queue).Receive(dto, reqErr)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -614,7 +648,7 @@ func (l *LOL) GrandmasterLeagues(queue string) (*LeagueListDTO, *http.Response,
 func (l *LOL) Leagues(leagueID string) (*LeagueListDTO, *http.Response, error) {
 	dto := new(LeagueListDTO)
 	var reqErr error
-	resp, err := l.sling.Get("league/v4/leagues/"+leagueID).Receive(dto, reqErr)
+	resp, err := l.sling.New().Get("league/v4/leagues/"+leagueID).Receive(dto, reqErr)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -625,7 +659,7 @@ func (l *LOL) Leagues(leagueID string) (*LeagueListDTO, *http.Response, error) {
 func (l *LOL) MasterLeagues(queue string) (*LeagueListDTO, *http.Response, error) {
 	dto := new(LeagueListDTO)
 	var reqErr error
-	resp, err := l.sling.Get("league/v4/masterleagues/by-queue/"+queue).Receive(dto, reqErr)
+	resp, err := l.sling.New().Get("league/v4/masterleagues/by-queue/"+queue).Receive(dto, reqErr)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -636,7 +670,7 @@ func (l *LOL) MasterLeagues(queue string) (*LeagueListDTO, *http.Response, error
 func (l *LOL) Status() (*ShardStatus, *http.Response, error) {
 	shardStatus := new(ShardStatus)
 	var reqErr error
-	resp, err := l.sling.Get("status/v3/shard-data").Receive(shardStatus, reqErr)
+	resp, err := l.sling.New().Get("status/v3/shard-data").Receive(shardStatus, reqErr)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -647,7 +681,29 @@ func (l *LOL) Status() (*ShardStatus, *http.Response, error) {
 func (l *LOL) Matches(matchID string) (*MatchDTO, *http.Response, error) {
 	dto := new(MatchDTO)
 	var reqErr error
-	resp, err := l.sling.Get("match/v4/matches/"+matchID).Receive(dto, reqErr)
+	resp, err := l.sling.New().Get("match/v4/matches/"+matchID).Receive(dto, reqErr)
+	if err != nil {
+		return nil, resp, err
+	}
+	return dto, resp, reqErr
+}
+
+// MatchIDsByTournamentCode GET /lol/match/v4/matches/by-tournament-code/{tournamentCode}/ids
+func (l *LOL) MatchIDsByTournamentCode(tournamentCode string) ([]int64, *http.Response, error) {
+	ids := new([]int64)
+	var reqErr error
+	resp, err := l.sling.New().Get("match/v4/matches/by-tournament-code/"+tournamentCode+"/ids").Receive(ids, reqErr)
+	if err != nil {
+		return nil, resp, err
+	}
+	return *ids, resp, reqErr
+}
+
+// MatchByTournamentCode GET /lol/match/v4/matches/{matchID}/by-tournament-code/{tournamentCode}
+func (l *LOL) MatchByTournamentCode(matchID, tournamentCode string) (*MatchDTO, *http.Response, error) {
+	dto := new(MatchDTO)
+	var reqErr error
+	resp, err := l.sling.New().Get("match/v4/matches/"+matchID+"/by-tournament-code/"+tournamentCode).Receive(dto, reqErr)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -658,7 +714,7 @@ func (l *LOL) Matches(matchID string) (*MatchDTO, *http.Response, error) {
 func (l *LOL) Matchlists(encryptedAccountID string, params *MatchlistsParams) (*MatchlistDTO, *http.Response, error) {
 	dto := new(MatchlistDTO)
 	var reqErr error
-	resp, err := l.sling.Get("match/v4/matchlists/by-account/"+encryptedAccountID).QueryStruct(params).Receive(dto, reqErr)
+	resp, err := l.sling.New().Get("match/v4/matchlists/by-account/"+encryptedAccountID).QueryStruct(params).Receive(dto, reqErr)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -669,7 +725,7 @@ func (l *LOL) Matchlists(encryptedAccountID string, params *MatchlistsParams) (*
 func (l *LOL) Timelines(matchID string) (*MatchTimelineDTO, *http.Response, error) {
 	dto := new(MatchTimelineDTO)
 	var reqErr error
-	resp, err := l.sling.Get("match/v4/timelines/by-match/"+matchID).Receive(dto, reqErr)
+	resp, err := l.sling.New().Get("match/v4/timelines/by-match/"+matchID).Receive(dto, reqErr)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -680,7 +736,7 @@ func (l *LOL) Timelines(matchID string) (*MatchTimelineDTO, *http.Response, erro
 func (l *LOL) ActiveGames(encryptedSummonerID string) (*CurrentGameInfo, *http.Response, error) {
 	info := new(CurrentGameInfo)
 	var reqErr error
-	resp, err := l.sling.Get("spectator/v4/active-games/by-summoner/"+encryptedSummonerID).Receive(info, reqErr)
+	resp, err := l.sling.New().Get("spectator/v4/active-games/by-summoner/"+encryptedSummonerID).Receive(info, reqErr)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -691,7 +747,7 @@ func (l *LOL) ActiveGames(encryptedSummonerID string) (*CurrentGameInfo, *http.R
 func (l *LOL) FeaturedGames() (*FeaturedGames, *http.Response, error) {
 	info := new(FeaturedGames)
 	var reqErr error
-	resp, err := l.sling.Get("spectator/v4/featured-games").Receive(info, reqErr)
+	resp, err := l.sling.New().Get("spectator/v4/featured-games").Receive(info, reqErr)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -702,7 +758,7 @@ func (l *LOL) FeaturedGames() (*FeaturedGames, *http.Response, error) {
 func (l *LOL) SummonerByAccount(encryptedAccountID string) (*SummonerDTO, *http.Response, error) {
 	sd := new(SummonerDTO)
 	var reqErr error
-	resp, err := l.sling.Get("summoner/v4/summoners/by-account/"+encryptedAccountID).Receive(sd, reqErr)
+	resp, err := l.sling.New().Get("summoner/v4/summoners/by-account/"+encryptedAccountID).Receive(sd, reqErr)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -713,7 +769,7 @@ func (l *LOL) SummonerByAccount(encryptedAccountID string) (*SummonerDTO, *http.
 func (l *LOL) SummonerByName(summonerName string) (*SummonerDTO, *http.Response, error) {
 	sd := new(SummonerDTO)
 	var reqErr error
-	resp, err := l.sling.Get("summoner/v4/summoners/by-name/"+summonerName).Receive(sd, reqErr)
+	resp, err := l.sling.New().Get("summoner/v4/summoners/by-name/"+summonerName).Receive(sd, reqErr)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -724,7 +780,7 @@ func (l *LOL) SummonerByName(summonerName string) (*SummonerDTO, *http.Response,
 func (l *LOL) SummonerByPUUID(encryptedPUUID string) (*SummonerDTO, *http.Response, error) {
 	sd := new(SummonerDTO)
 	var reqErr error
-	resp, err := l.sling.Get("summoner/v4/summoners/by-puuid/"+encryptedPUUID).Receive(sd, reqErr)
+	resp, err := l.sling.New().Get("summoner/v4/summoners/by-puuid/"+encryptedPUUID).Receive(sd, reqErr)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -735,7 +791,7 @@ func (l *LOL) SummonerByPUUID(encryptedPUUID string) (*SummonerDTO, *http.Respon
 func (l *LOL) SummonerByID(encryptedID string) (*SummonerDTO, *http.Response, error) {
 	sd := new(SummonerDTO)
 	var reqErr error
-	resp, err := l.sling.Get("summoner/v4/summoners/"+encryptedID).Receive(sd, reqErr)
+	resp, err := l.sling.New().Get("summoner/v4/summoners/"+encryptedID).Receive(sd, reqErr)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -192,6 +192,39 @@ func TestChampionRotations(t *testing.T) {
 	}
 }
 
+func TestThirdPartyCode(t *testing.T) {
+	rec, err := recorder.New("cassettes/lol/third-party-code-v4/third-party-code")
+	if err != nil {
+		log.Fatal(err)
+	} else {
+		rec.SetMatcher(matchWithoutToken)
+		httpClient = &http.Client{Transport: rec}
+	}
+	rec.AddFilter(removeToken)
+	defer rec.Stop()
+	cli, err := NewClient(testToken, WithHTTPClient(httpClient))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	code, resp, err := cli.ThirdPartyCode(encryptedSummonerID)
+	if resp.StatusCode != 200 {
+		t.Errorf("\nExpected: 200 status code\nActual: %d status code", resp.StatusCode)
+		return
+	}
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	expected := "ILIKEDUCK"
+	actual := code
+	if expected != actual {
+		t.Errorf("\nExpected: %s\nActual: %s\n", expected, actual)
+		return
+	}
+}
+
 func TestLeagueExpEntries(t *testing.T) {
 	rec, err := recorder.New("cassettes/lol/league-exp-v4/league-exp-entries")
 	if err != nil {
@@ -489,6 +522,73 @@ func TestMatches(t *testing.T) {
 	}
 }
 
+func TestMatchIDsByTournamentCode(t *testing.T) {
+	rec, err := recorder.New("cassettes/lol/match-v4/match-ids-by-tournament-code")
+	if err != nil {
+		log.Fatal(err)
+	} else {
+		rec.SetMatcher(matchWithoutToken)
+		httpClient = &http.Client{Transport: rec}
+	}
+	rec.AddFilter(removeToken)
+	defer rec.Stop()
+	cli, err := NewClient(testToken, WithHTTPClient(httpClient))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	ids, resp, err := cli.MatchIDsByTournamentCode(tournamentCode)
+	if resp.StatusCode != 200 {
+		t.Errorf("\nExpected: 200 status code\nActual: %d status code", resp.StatusCode)
+		return
+	}
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	var expected int64
+	expected = 3198831326
+	actual := ids[0]
+	if expected != actual {
+		t.Errorf("\nExpected: %d\nActual: %d\n", expected, actual)
+		return
+	}
+}
+
+func TestMatchByTournamentCode(t *testing.T) {
+	rec, err := recorder.New("cassettes/lol/match-v4/match-by-tournament-code")
+	if err != nil {
+		log.Fatal(err)
+	} else {
+		rec.SetMatcher(matchWithoutToken)
+		httpClient = &http.Client{Transport: rec}
+	}
+	rec.AddFilter(removeToken)
+	defer rec.Stop()
+	cli, err := NewClient(testToken, WithHTTPClient(httpClient))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	dto, resp, err := cli.MatchByTournamentCode(matchID, tournamentCode)
+	if resp.StatusCode != 200 {
+		t.Errorf("\nExpected: 200 status code\nActual: %d status code", resp.StatusCode)
+		return
+	}
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	expected := 13
+	actual := dto.SeasonID
+	if expected != actual {
+		t.Errorf("\nExpected: %d\nActual: %d\n", expected, actual)
+		return
+	}
+}
+
 func TestMatchlists(t *testing.T) {
 	rec, err := recorder.New("cassettes/lol/match-v4/matchlists")
 	if err != nil {
@@ -575,7 +675,6 @@ func TestActiveGames(t *testing.T) {
 	if resp.StatusCode != 200 {
 		t.Errorf("\nExpected: 200 status code\nActual: %d status code", resp.StatusCode)
 		return
-		return
 	}
 	if err != nil {
 		t.Error(err)
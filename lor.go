@@ -0,0 +1,136 @@
+package lol
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/dghubble/sling"
+)
+
+// LOR provides methods to interface with Legends of Runeterra resource
+type LOR struct {
+	sling *sling.Sling
+}
+
+// Game outcomes of LORMatchPlayerDTO
+const (
+	LORGameOutcomeWin  = "win"
+	LORGameOutcomeLoss = "loss"
+	LORGameOutcomeTie  = "tie"
+)
+
+// Factions as reported in LORMatchPlayerDTO.Factions
+const (
+	LORFactionDemacia     = "faction_Demacia_Name"
+	LORFactionFreljord    = "faction_Freljord_Name"
+	LORFactionIonia       = "faction_Ionia_Name"
+	LORFactionNoxus       = "faction_Noxus_Name"
+	LORFactionPiltover    = "faction_Piltover_Name"
+	LORFactionShadowIsles = "faction_ShadowIsles_Name"
+	LORFactionBilgewater  = "faction_Bilgewater_Name"
+	LORFactionShurima     = "faction_Shurima_Name"
+	LORFactionMtTargon    = "faction_MtTargon_Name"
+	LORFactionBandleCity  = "faction_BandleCity_Name"
+)
+
+type LORLeaderboardDTO struct {
+	Players []LORPlayerDTO `json:"players"`
+}
+
+type LORPlayerDTO struct {
+	Name string `json:"name"`
+	Rank int    `json:"rank"`
+	LP   int    `json:"lp"`
+}
+
+type LORMatchDTO struct {
+	Metadata LORMetadataDTO `json:"metadata"`
+	Info     LORInfoDTO     `json:"info"`
+}
+
+type LORMetadataDTO struct {
+	DataVersion  string   `json:"data_version"`
+	MatchID      string   `json:"match_id"`
+	Participants []string `json:"participants"`
+}
+
+type LORInfoDTO struct {
+	GameMode         string              `json:"game_mode"`
+	GameType         string              `json:"game_type"`
+	GameStartTimeUTC string              `json:"game_start_time_utc"`
+	GameVersion      string              `json:"game_version"`
+	Players          []LORMatchPlayerDTO `json:"players"`
+	TotalTurnCount   int                 `json:"total_turn_count"`
+}
+
+type LORMatchPlayerDTO struct {
+	Puuid       string   `json:"puuid"`
+	DeckID      string   `json:"deck_id"`
+	DeckCode    string   `json:"deck_code"`
+	Factions    []string `json:"factions"`
+	GameOutcome string   `json:"game_outcome"`
+	OrderOfPlay int      `json:"order_of_play"`
+}
+
+// NewLOR returns a new LOR, sling must be based on the LoR regional routing value
+func NewLOR(sling *sling.Sling) *LOR {
+	return &LOR{sling: sling.New().Path("lor/")}
+}
+
+// Leaderboards GET /lor/ranked/v1/leaderboards
+func (l *LOR) Leaderboards() (*LORLeaderboardDTO, *http.Response, error) {
+	dto := new(LORLeaderboardDTO)
+	var reqErr error
+	resp, err := l.sling.New().Get("ranked/v1/leaderboards").Receive(dto, reqErr)
+	if err != nil {
+		return nil, resp, err
+	}
+	return dto, resp, reqErr
+}
+
+// MatchIDs GET /lor/match/v1/matches/by-puuid/{puuid}/ids
+func (l *LOR) MatchIDs(puuid string) ([]string, *http.Response, error) {
+	ids := new([]string)
+	var reqErr error
+	resp, err := l.sling.New().Get("match/v1/matches/by-puuid/"+puuid+"/ids").Receive(ids, reqErr)
+	if err != nil {
+		return nil, resp, err
+	}
+	return *ids, resp, reqErr
+}
+
+// Match GET /lor/match/v1/matches/{matchId}
+func (l *LOR) Match(matchID string) (*LORMatchDTO, *http.Response, error) {
+	dto := new(LORMatchDTO)
+	var reqErr error
+	resp, err := l.sling.New().Get("match/v1/matches/"+matchID).Receive(dto, reqErr)
+	if err != nil {
+		return nil, resp, err
+	}
+	return dto, resp, reqErr
+}
+
+// Player returns the match player with the given PUUID or nil when they did not play the match
+func (m *LORMatchDTO) Player(puuid string) *LORMatchPlayerDTO {
+	for i := range m.Info.Players {
+		if m.Info.Players[i].Puuid == puuid {
+			return &m.Info.Players[i]
+		}
+	}
+	return nil
+}
+
+// Won reports whether the player won the match
+func (p *LORMatchPlayerDTO) Won() bool {
+	return p.GameOutcome == LORGameOutcomeWin
+}
+
+// FactionNames returns the factions without the localization key decoration,
+// faction_ShadowIsles_Name becomes ShadowIsles
+func (p *LORMatchPlayerDTO) FactionNames() []string {
+	names := make([]string, len(p.Factions))
+	for i, f := range p.Factions {
+		names[i] = strings.TrimSuffix(strings.TrimPrefix(f, "faction_"), "_Name")
+	}
+	return names
+}
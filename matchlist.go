@@ -0,0 +1,153 @@
+package lol
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+)
+
+const (
+	// matchlistPageSize is the most matches match-v4 returns per request
+	matchlistPageSize = 100
+	// matchlistMaxWindow is the longest beginTime to endTime span match-v4 accepts
+	matchlistMaxWindow = 7 * 24 * time.Hour
+)
+
+// MatchlistIterator pages through a matchlist newest match first. Time ranges longer than the
+// one week match-v4 allows are split into consecutive requests.
+//
+//	it := cli.MatchlistIterator(encryptedAccountID, &lol.MatchlistsParams{Queue: []lol.Queue{lol.QueueRankedSolo}})
+//	for it.Next() {
+//		ref := it.Match()
+//		// ...
+//	}
+//	err := it.Err()
+type MatchlistIterator struct {
+	lol       *LOL
+	accountID string
+	params    MatchlistsParams
+	windows   [][2]int
+	index     int
+	page      []MatchReferenceDTO
+	pos       int
+	done      bool
+	err       error
+}
+
+// MatchlistIterator returns an iterator over the matchlist of encryptedAccountID.
+// BeginIndex and EndIndex of params are managed by the iterator and ignored.
+func (l *LOL) MatchlistIterator(encryptedAccountID string, params *MatchlistsParams) *MatchlistIterator {
+	it := &MatchlistIterator{lol: l, accountID: encryptedAccountID}
+	if params != nil {
+		it.params = *params
+	}
+	it.params.BeginIndex, it.params.EndIndex = 0, 0
+	it.windows = matchlistWindows(it.params.BeginTime, it.params.EndTime)
+	return it
+}
+
+// PatchMatchlistIterator returns an iterator over the matches encryptedAccountID played from patch
+// from through patch to, using the release days in calendar as time range. A nil calendar uses
+// DefaultPatchCalendar.
+func (l *LOL) PatchMatchlistIterator(encryptedAccountID string, calendar PatchCalendar, from, to Patch, params *MatchlistsParams) (*MatchlistIterator, error) {
+	if calendar == nil {
+		calendar = DefaultPatchCalendar
+	}
+	begin, end, err := calendar.Range(from, to)
+	if err != nil {
+		return nil, err
+	}
+	p := MatchlistsParams{}
+	if params != nil {
+		p = *params
+	}
+	p.BeginTime = int(begin.UnixNano() / int64(time.Millisecond))
+	if end.IsZero() {
+		p.EndTime = int(time.Now().UnixNano() / int64(time.Millisecond))
+	} else {
+		// EndTime is inclusive and end is when the next patch was released
+		p.EndTime = int(end.UnixNano()/int64(time.Millisecond)) - 1
+	}
+	return l.MatchlistIterator(encryptedAccountID, &p), nil
+}
+
+// Next advances to the next match, it returns false when the matchlist is exhausted or on error
+func (it *MatchlistIterator) Next() bool {
+	for {
+		if it.pos < len(it.page) {
+			it.pos++
+			return true
+		}
+		if it.done || it.err != nil {
+			return false
+		}
+		it.fetch()
+	}
+}
+
+// Match returns the current match, only valid after Next returned true
+func (it *MatchlistIterator) Match() MatchReferenceDTO {
+	return it.page[it.pos-1]
+}
+
+// Err returns the error that stopped the iteration, if any
+func (it *MatchlistIterator) Err() error {
+	return it.err
+}
+
+// fetch loads the next page of the current window, moving to the next window once it is drained
+func (it *MatchlistIterator) fetch() {
+	it.page, it.pos = nil, 0
+	if len(it.windows) == 0 {
+		it.done = true
+		return
+	}
+	p := it.params
+	p.BeginTime, p.EndTime = it.windows[0][0], it.windows[0][1]
+	p.BeginIndex, p.EndIndex = it.index, it.index+matchlistPageSize
+
+	dto, resp, err := it.lol.Matchlists(it.accountID, &p)
+	switch {
+	case err != nil:
+		it.err = err
+		return
+	case resp.StatusCode == http.StatusNotFound:
+		// match-v4 answers 404 when the window holds no matches
+		it.nextWindow()
+		return
+	case resp.StatusCode != http.StatusOK:
+		it.err = fmt.Errorf("lol: matchlist request failed: %s", resp.Status)
+		return
+	}
+	it.page = dto.Matches
+	it.index += len(dto.Matches)
+	if len(dto.Matches) < matchlistPageSize {
+		it.nextWindow()
+	}
+}
+
+func (it *MatchlistIterator) nextWindow() {
+	it.windows = it.windows[1:]
+	it.index = 0
+}
+
+// matchlistWindows splits beginTime to endTime, in epoch milliseconds, into spans match-v4
+// accepts, newest first. Both bounds are inclusive so windows do not share a millisecond,
+// otherwise a match created on a boundary would be listed twice. Unbounded ranges are left to
+// the API as a single window.
+func matchlistWindows(beginTime, endTime int) [][2]int {
+	if beginTime == 0 || endTime == 0 {
+		return [][2]int{{beginTime, endTime}}
+	}
+	week := int(matchlistMaxWindow / time.Millisecond)
+	var windows [][2]int
+	for end := endTime; end >= beginTime; {
+		begin := end - week
+		if begin < beginTime {
+			begin = beginTime
+		}
+		windows = append(windows, [2]int{begin, end})
+		end = begin - 1
+	}
+	return windows
+}
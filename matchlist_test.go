@@ -0,0 +1,117 @@
+package lol
+
+import (
+	"log"
+	"net/http"
+	"testing"
+
+	"github.com/dnaeon/go-vcr/recorder"
+)
+
+func TestPatchMatchlistIterator(t *testing.T) {
+	rec, err := recorder.New("cassettes/lol/match-v4/matchlist-iterator")
+	if err != nil {
+		log.Fatal(err)
+	} else {
+		rec.SetMatcher(matchWithoutToken)
+		httpClient = &http.Client{Transport: rec}
+	}
+	rec.AddFilter(removeToken)
+	defer rec.Stop()
+	cli, err := NewClient(testToken, WithHTTPClient(httpClient))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	patch := Patch{Major: 9, Minor: 22}
+	it, err := cli.PatchMatchlistIterator(encryptedAccountID, nil, patch, patch, &MatchlistsParams{Queue: []Queue{QueueRankedSolo}})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	count := 0
+	for it.Next() {
+		if it.Match().Queue != int(QueueRankedSolo) {
+			t.Errorf("\nExpected: queue %d\nActual: %d\n", QueueRankedSolo, it.Match().Queue)
+			return
+		}
+		count++
+	}
+	if err := it.Err(); err != nil {
+		t.Error(err)
+		return
+	}
+	expected := 120
+	actual := count
+	if expected != actual {
+		t.Errorf("\nExpected: %d\nActual: %d\n", expected, actual)
+		return
+	}
+}
+
+func TestPatchMatchlistIteratorCalendar(t *testing.T) {
+	rec, err := recorder.New("cassettes/lol/match-v4/matchlist-iterator")
+	if err != nil {
+		log.Fatal(err)
+	} else {
+		rec.SetMatcher(matchWithoutToken)
+		httpClient = &http.Client{Transport: rec}
+	}
+	rec.AddFilter(removeToken)
+	defer rec.Stop()
+	cli, err := NewClient(testToken, WithHTTPClient(httpClient))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	// a patch DefaultPatchCalendar does not know, released on the days of 9.22 and 9.23
+	patch := Patch{Major: 99, Minor: 1}
+	calendar := PatchCalendar{
+		patch:                 DefaultPatchCalendar[Patch{Major: 9, Minor: 22}],
+		{Major: 99, Minor: 2}: DefaultPatchCalendar[Patch{Major: 9, Minor: 23}],
+	}
+	if _, err := cli.PatchMatchlistIterator(encryptedAccountID, nil, patch, patch, nil); err == nil {
+		t.Errorf("\nExpected: error for patch %s\nActual: nil\n", patch)
+		return
+	}
+	it, err := cli.PatchMatchlistIterator(encryptedAccountID, calendar, patch, patch, &MatchlistsParams{Queue: []Queue{QueueRankedSolo}})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	count := 0
+	for it.Next() {
+		count++
+	}
+	if err := it.Err(); err != nil {
+		t.Error(err)
+		return
+	}
+	expected := 120
+	actual := count
+	if expected != actual {
+		t.Errorf("\nExpected: %d\nActual: %d\n", expected, actual)
+		return
+	}
+}
+
+func TestMatchlistWindows(t *testing.T) {
+	day := 24 * 60 * 60 * 1000
+	windows := matchlistWindows(day, 16*day)
+	expected := [][2]int{{9 * day, 16 * day}, {2*day - 1, 9*day - 1}, {day, 2*day - 2}}
+	if len(expected) != len(windows) {
+		t.Errorf("\nExpected: %v\nActual: %v\n", expected, windows)
+		return
+	}
+	for i := range expected {
+		if expected[i] != windows[i] {
+			t.Errorf("\nExpected: %v\nActual: %v\n", expected, windows)
+			return
+		}
+	}
+	if actual := matchlistWindows(0, 0); len(actual) != 1 {
+		t.Errorf("\nExpected: one unbounded window\nActual: %v\n", actual)
+	}
+}
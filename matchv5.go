@@ -0,0 +1,377 @@
+package lol
+
+import (
+	"net/http"
+	"time"
+)
+
+type MatchIDsParams struct {
+	StartTime int64  `url:"startTime,omitempty"`
+	EndTime   int64  `url:"endTime,omitempty"`
+	Queue     int    `url:"queue,omitempty"`
+	Type      string `url:"type,omitempty"`
+	Start     int    `url:"start,omitempty"`
+	Count     int    `url:"count,omitempty"`
+}
+
+type MatchV5DTO struct {
+	Metadata MatchMetadataV5DTO `json:"metadata"`
+	Info     MatchInfoV5DTO     `json:"info"`
+}
+
+type MatchMetadataV5DTO struct {
+	DataVersion  string   `json:"dataVersion"`
+	MatchID      string   `json:"matchId"`
+	Participants []string `json:"participants"`
+}
+
+type MatchInfoV5DTO struct {
+	EndOfGameResult    string             `json:"endOfGameResult"`
+	GameCreation       int64              `json:"gameCreation"`
+	GameDuration       int64              `json:"gameDuration"`
+	GameEndTimestamp   int64              `json:"gameEndTimestamp"`
+	GameID             int64              `json:"gameId"`
+	GameMode           string             `json:"gameMode"`
+	GameName           string             `json:"gameName"`
+	GameStartTimestamp int64              `json:"gameStartTimestamp"`
+	GameType           string             `json:"gameType"`
+	GameVersion        string             `json:"gameVersion"`
+	MapID              int                `json:"mapId"`
+	Participants       []ParticipantV5DTO `json:"participants"`
+	PlatformID         string             `json:"platformId"`
+	QueueID            int                `json:"queueId"`
+	Teams              []TeamV5DTO        `json:"teams"`
+	TournamentCode     string             `json:"tournamentCode"`
+}
+
+type ParticipantV5DTO struct {
+	AllInPings                     int        `json:"allInPings"`
+	AssistMePings                  int        `json:"assistMePings"`
+	Assists                        int        `json:"assists"`
+	BaronKills                     int        `json:"baronKills"`
+	BountyLevel                    int        `json:"bountyLevel"`
+	ChampExperience                int        `json:"champExperience"`
+	ChampLevel                     int        `json:"champLevel"`
+	ChampionID                     int        `json:"championId"`
+	ChampionName                   string     `json:"championName"`
+	ChampionTransform              int        `json:"championTransform"`
+	CommandPings                   int        `json:"commandPings"`
+	ConsumablesPurchased           int        `json:"consumablesPurchased"`
+	DamageDealtToBuildings         int        `json:"damageDealtToBuildings"`
+	DamageDealtToObjectives        int        `json:"damageDealtToObjectives"`
+	DamageDealtToTurrets           int        `json:"damageDealtToTurrets"`
+	DamageSelfMitigated            int        `json:"damageSelfMitigated"`
+	Deaths                         int        `json:"deaths"`
+	DetectorWardsPlaced            int        `json:"detectorWardsPlaced"`
+	DoubleKills                    int        `json:"doubleKills"`
+	DragonKills                    int        `json:"dragonKills"`
+	EligibleForProgression         bool       `json:"eligibleForProgression"`
+	EnemyMissingPings              int        `json:"enemyMissingPings"`
+	EnemyVisionPings               int        `json:"enemyVisionPings"`
+	FirstBloodAssist               bool       `json:"firstBloodAssist"`
+	FirstBloodKill                 bool       `json:"firstBloodKill"`
+	FirstTowerAssist               bool       `json:"firstTowerAssist"`
+	FirstTowerKill                 bool       `json:"firstTowerKill"`
+	GameEndedInEarlySurrender      bool       `json:"gameEndedInEarlySurrender"`
+	GameEndedInSurrender           bool       `json:"gameEndedInSurrender"`
+	GetBackPings                   int        `json:"getBackPings"`
+	GoldEarned                     int        `json:"goldEarned"`
+	GoldSpent                      int        `json:"goldSpent"`
+	HoldPings                      int        `json:"holdPings"`
+	IndividualPosition             string     `json:"individualPosition"`
+	InhibitorKills                 int        `json:"inhibitorKills"`
+	InhibitorTakedowns             int        `json:"inhibitorTakedowns"`
+	InhibitorsLost                 int        `json:"inhibitorsLost"`
+	Item0                          int        `json:"item0"`
+	Item1                          int        `json:"item1"`
+	Item2                          int        `json:"item2"`
+	Item3                          int        `json:"item3"`
+	Item4                          int        `json:"item4"`
+	Item5                          int        `json:"item5"`
+	Item6                          int        `json:"item6"`
+	ItemsPurchased                 int        `json:"itemsPurchased"`
+	KillingSprees                  int        `json:"killingSprees"`
+	Kills                          int        `json:"kills"`
+	Lane                           string     `json:"lane"`
+	LargestCriticalStrike          int        `json:"largestCriticalStrike"`
+	LargestKillingSpree            int        `json:"largestKillingSpree"`
+	LargestMultiKill               int        `json:"largestMultiKill"`
+	LongestTimeSpentLiving         int        `json:"longestTimeSpentLiving"`
+	MagicDamageDealt               int        `json:"magicDamageDealt"`
+	MagicDamageDealtToChampions    int        `json:"magicDamageDealtToChampions"`
+	MagicDamageTaken               int        `json:"magicDamageTaken"`
+	NeedVisionPings                int        `json:"needVisionPings"`
+	NeutralMinionsKilled           int        `json:"neutralMinionsKilled"`
+	NexusKills                     int        `json:"nexusKills"`
+	NexusLost                      int        `json:"nexusLost"`
+	NexusTakedowns                 int        `json:"nexusTakedowns"`
+	ObjectivesStolen               int        `json:"objectivesStolen"`
+	ObjectivesStolenAssists        int        `json:"objectivesStolenAssists"`
+	OnMyWayPings                   int        `json:"onMyWayPings"`
+	ParticipantID                  int        `json:"participantId"`
+	PentaKills                     int        `json:"pentaKills"`
+	Perks                          PerksV5DTO `json:"perks"`
+	PhysicalDamageDealt            int        `json:"physicalDamageDealt"`
+	PhysicalDamageDealtToChampions int        `json:"physicalDamageDealtToChampions"`
+	PhysicalDamageTaken            int        `json:"physicalDamageTaken"`
+	ProfileIcon                    int        `json:"profileIcon"`
+	PushPings                      int        `json:"pushPings"`
+	Puuid                          string     `json:"puuid"`
+	QuadraKills                    int        `json:"quadraKills"`
+	RiotIDGameName                 string     `json:"riotIdGameName"`
+	RiotIDTagline                  string     `json:"riotIdTagline"`
+	Role                           string     `json:"role"`
+	SightWardsBoughtInGame         int        `json:"sightWardsBoughtInGame"`
+	Spell1Casts                    int        `json:"spell1Casts"`
+	Spell2Casts                    int        `json:"spell2Casts"`
+	Spell3Casts                    int        `json:"spell3Casts"`
+	Spell4Casts                    int        `json:"spell4Casts"`
+	Summoner1Casts                 int        `json:"summoner1Casts"`
+	Summoner1ID                    int        `json:"summoner1Id"`
+	Summoner2Casts                 int        `json:"summoner2Casts"`
+	Summoner2ID                    int        `json:"summoner2Id"`
+	SummonerID                     string     `json:"summonerId"`
+	SummonerLevel                  int        `json:"summonerLevel"`
+	SummonerName                   string     `json:"summonerName"`
+	TeamEarlySurrendered           bool       `json:"teamEarlySurrendered"`
+	TeamID                         int        `json:"teamId"`
+	TeamPosition                   string     `json:"teamPosition"`
+	TimeCCingOthers                int        `json:"timeCCingOthers"`
+	TimePlayed                     int        `json:"timePlayed"`
+	TotalAllyJungleMinionsKilled   int        `json:"totalAllyJungleMinionsKilled"`
+	TotalDamageDealt               int        `json:"totalDamageDealt"`
+	TotalDamageDealtToChampions    int        `json:"totalDamageDealtToChampions"`
+	TotalDamageShieldedOnTeammates int        `json:"totalDamageShieldedOnTeammates"`
+	TotalDamageTaken               int        `json:"totalDamageTaken"`
+	TotalEnemyJungleMinionsKilled  int        `json:"totalEnemyJungleMinionsKilled"`
+	TotalHeal                      int        `json:"totalHeal"`
+	TotalHealsOnTeammates          int        `json:"totalHealsOnTeammates"`
+	TotalMinionsKilled             int        `json:"totalMinionsKilled"`
+	TotalTimeCCDealt               int        `json:"totalTimeCCDealt"`
+	TotalTimeSpentDead             int        `json:"totalTimeSpentDead"`
+	TotalUnitsHealed               int        `json:"totalUnitsHealed"`
+	TripleKills                    int        `json:"tripleKills"`
+	TrueDamageDealt                int        `json:"trueDamageDealt"`
+	TrueDamageDealtToChampions     int        `json:"trueDamageDealtToChampions"`
+	TrueDamageTaken                int        `json:"trueDamageTaken"`
+	TurretKills                    int        `json:"turretKills"`
+	TurretTakedowns                int        `json:"turretTakedowns"`
+	TurretsLost                    int        `json:"turretsLost"`
+	UnrealKills                    int        `json:"unrealKills"`
+	VisionClearedPings             int        `json:"visionClearedPings"`
+	VisionScore                    int        `json:"visionScore"`
+	VisionWardsBoughtInGame        int        `json:"visionWardsBoughtInGame"`
+	WardsKilled                    int        `json:"wardsKilled"`
+	WardsPlaced                    int        `json:"wardsPlaced"`
+	Win                            bool       `json:"win"`
+}
+
+type PerksV5DTO struct {
+	StatPerks PerkStatsV5DTO   `json:"statPerks"`
+	Styles    []PerkStyleV5DTO `json:"styles"`
+}
+
+type PerkStatsV5DTO struct {
+	Defense int `json:"defense"`
+	Flex    int `json:"flex"`
+	Offense int `json:"offense"`
+}
+
+type PerkStyleV5DTO struct {
+	Description string                    `json:"description"`
+	Selections  []PerkStyleSelectionV5DTO `json:"selections"`
+	Style       int                       `json:"style"`
+}
+
+type PerkStyleSelectionV5DTO struct {
+	Perk int `json:"perk"`
+	Var1 int `json:"var1"`
+	Var2 int `json:"var2"`
+	Var3 int `json:"var3"`
+}
+
+type TeamV5DTO struct {
+	Bans       []BanV5DTO      `json:"bans"`
+	Objectives ObjectivesV5DTO `json:"objectives"`
+	TeamID     int             `json:"teamId"`
+	Win        bool            `json:"win"`
+}
+
+type BanV5DTO struct {
+	ChampionID int `json:"championId"`
+	PickTurn   int `json:"pickTurn"`
+}
+
+type ObjectivesV5DTO struct {
+	Baron      ObjectiveV5DTO `json:"baron"`
+	Champion   ObjectiveV5DTO `json:"champion"`
+	Dragon     ObjectiveV5DTO `json:"dragon"`
+	Horde      ObjectiveV5DTO `json:"horde"`
+	Inhibitor  ObjectiveV5DTO `json:"inhibitor"`
+	RiftHerald ObjectiveV5DTO `json:"riftHerald"`
+	Tower      ObjectiveV5DTO `json:"tower"`
+}
+
+type ObjectiveV5DTO struct {
+	First bool `json:"first"`
+	Kills int  `json:"kills"`
+}
+
+type TimelineV5DTO struct {
+	Metadata MatchMetadataV5DTO `json:"metadata"`
+	Info     TimelineInfoV5DTO  `json:"info"`
+}
+
+type TimelineInfoV5DTO struct {
+	EndOfGameResult string                     `json:"endOfGameResult"`
+	FrameInterval   int64                      `json:"frameInterval"`
+	GameID          int64                      `json:"gameId"`
+	Participants    []TimelineParticipantV5DTO `json:"participants"`
+	Frames          []FrameV5DTO               `json:"frames"`
+}
+
+type TimelineParticipantV5DTO struct {
+	ParticipantID int    `json:"participantId"`
+	Puuid         string `json:"puuid"`
+}
+
+type FrameV5DTO struct {
+	Events            []EventV5DTO                     `json:"events"`
+	ParticipantFrames map[string]ParticipantFrameV5DTO `json:"participantFrames"`
+	Timestamp         int                              `json:"timestamp"`
+}
+
+type ParticipantFrameV5DTO struct {
+	ChampionStats            ChampionStatsV5DTO `json:"championStats"`
+	CurrentGold              int                `json:"currentGold"`
+	DamageStats              DamageStatsV5DTO   `json:"damageStats"`
+	GoldPerSecond            int                `json:"goldPerSecond"`
+	JungleMinionsKilled      int                `json:"jungleMinionsKilled"`
+	Level                    int                `json:"level"`
+	MinionsKilled            int                `json:"minionsKilled"`
+	ParticipantID            int                `json:"participantId"`
+	Position                 MatchPositionDTO   `json:"position"`
+	TimeEnemySpentControlled int                `json:"timeEnemySpentControlled"`
+	TotalGold                int                `json:"totalGold"`
+	XP                       int                `json:"xp"`
+}
+
+type ChampionStatsV5DTO struct {
+	AbilityHaste         int `json:"abilityHaste"`
+	AbilityPower         int `json:"abilityPower"`
+	Armor                int `json:"armor"`
+	ArmorPen             int `json:"armorPen"`
+	ArmorPenPercent      int `json:"armorPenPercent"`
+	AttackDamage         int `json:"attackDamage"`
+	AttackSpeed          int `json:"attackSpeed"`
+	BonusArmorPenPercent int `json:"bonusArmorPenPercent"`
+	BonusMagicPenPercent int `json:"bonusMagicPenPercent"`
+	CcReduction          int `json:"ccReduction"`
+	CooldownReduction    int `json:"cooldownReduction"`
+	Health               int `json:"health"`
+	HealthMax            int `json:"healthMax"`
+	HealthRegen          int `json:"healthRegen"`
+	Lifesteal            int `json:"lifesteal"`
+	MagicPen             int `json:"magicPen"`
+	MagicPenPercent      int `json:"magicPenPercent"`
+	MagicResist          int `json:"magicResist"`
+	MovementSpeed        int `json:"movementSpeed"`
+	Omnivamp             int `json:"omnivamp"`
+	PhysicalVamp         int `json:"physicalVamp"`
+	Power                int `json:"power"`
+	PowerMax             int `json:"powerMax"`
+	PowerRegen           int `json:"powerRegen"`
+	SpellVamp            int `json:"spellVamp"`
+}
+
+type DamageStatsV5DTO struct {
+	MagicDamageDone               int `json:"magicDamageDone"`
+	MagicDamageDoneToChampions    int `json:"magicDamageDoneToChampions"`
+	MagicDamageTaken              int `json:"magicDamageTaken"`
+	PhysicalDamageDone            int `json:"physicalDamageDone"`
+	PhysicalDamageDoneToChampions int `json:"physicalDamageDoneToChampions"`
+	PhysicalDamageTaken           int `json:"physicalDamageTaken"`
+	TotalDamageDone               int `json:"totalDamageDone"`
+	TotalDamageDoneToChampions    int `json:"totalDamageDoneToChampions"`
+	TotalDamageTaken              int `json:"totalDamageTaken"`
+	TrueDamageDone                int `json:"trueDamageDone"`
+	TrueDamageDoneToChampions     int `json:"trueDamageDoneToChampions"`
+	TrueDamageTaken               int `json:"trueDamageTaken"`
+}
+
+type EventV5DTO struct {
+	Timestamp               int64            `json:"timestamp"`
+	RealTimestamp           int64            `json:"realTimestamp"`
+	Type                    string           `json:"type"`
+	ParticipantID           int              `json:"participantId"`
+	ItemID                  int              `json:"itemId"`
+	AfterID                 int              `json:"afterId"`
+	BeforeID                int              `json:"beforeId"`
+	GoldGain                int              `json:"goldGain"`
+	SkillSlot               int              `json:"skillSlot"`
+	LevelUpType             string           `json:"levelUpType"`
+	Level                   int              `json:"level"`
+	WardType                string           `json:"wardType"`
+	CreatorID               int              `json:"creatorId"`
+	KillerID                int              `json:"killerId"`
+	VictimID                int              `json:"victimId"`
+	AssistingParticipantIDs []int            `json:"assistingParticipantIds"`
+	Bounty                  int              `json:"bounty"`
+	ShutdownBounty          int              `json:"shutdownBounty"`
+	KillStreakLength        int              `json:"killStreakLength"`
+	KillType                string           `json:"killType"`
+	MultiKillLength         int              `json:"multiKillLength"`
+	Position                MatchPositionDTO `json:"position"`
+	MonsterType             string           `json:"monsterType"`
+	MonsterSubType          string           `json:"monsterSubType"`
+	KillerTeamID            int              `json:"killerTeamId"`
+	TeamID                  int              `json:"teamId"`
+	BuildingType            string           `json:"buildingType"`
+	LaneType                string           `json:"laneType"`
+	TowerType               string           `json:"towerType"`
+	TransformType           string           `json:"transformType"`
+	GameID                  int64            `json:"gameId"`
+	WinningTeam             int              `json:"winningTeam"`
+}
+
+// Duration returns the game length. gameDuration is in milliseconds for matches without a
+// gameEndTimestamp, which includes matches made by ConvertMatch, and in seconds otherwise.
+func (i *MatchInfoV5DTO) Duration() time.Duration {
+	if i.GameEndTimestamp == 0 {
+		return time.Duration(i.GameDuration) * time.Millisecond
+	}
+	return time.Duration(i.GameDuration) * time.Second
+}
+
+// MatchIDsByPUUID GET /lol/match/v5/matches/by-puuid/{puuid}/ids
+func (l *LOL) MatchIDsByPUUID(puuid string, params *MatchIDsParams) ([]string, *http.Response, error) {
+	ids := new([]string)
+	var reqErr error
+	resp, err := l.regional.New().Get("match/v5/matches/by-puuid/"+puuid+"/ids").QueryStruct(params).Receive(ids, reqErr)
+	if err != nil {
+		return nil, resp, err
+	}
+	return *ids, resp, reqErr
+}
+
+// MatchV5 GET /lol/match/v5/matches/{matchID}
+func (l *LOL) MatchV5(matchID string) (*MatchV5DTO, *http.Response, error) {
+	dto := new(MatchV5DTO)
+	var reqErr error
+	resp, err := l.regional.New().Get("match/v5/matches/"+matchID).Receive(dto, reqErr)
+	if err != nil {
+		return nil, resp, err
+	}
+	return dto, resp, reqErr
+}
+
+// TimelineV5 GET /lol/match/v5/matches/{matchID}/timeline
+func (l *LOL) TimelineV5(matchID string) (*TimelineV5DTO, *http.Response, error) {
+	dto := new(TimelineV5DTO)
+	var reqErr error
+	resp, err := l.regional.New().Get("match/v5/matches/"+matchID+"/timeline").Receive(dto, reqErr)
+	if err != nil {
+		return nil, resp, err
+	}
+	return dto, resp, reqErr
+}
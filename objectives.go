@@ -0,0 +1,237 @@
+package lol
+
+import (
+	"fmt"
+	"time"
+)
+
+// Objective types, the MonsterType of elite monsters and the BuildingType of buildings
+const (
+	ObjectiveDragon     = "DRAGON"
+	ObjectiveBaron      = "BARON_NASHOR"
+	ObjectiveRiftHerald = "RIFTHERALD"
+	ObjectiveTower      = "TOWER_BUILDING"
+	ObjectiveInhibitor  = "INHIBITOR_BUILDING"
+)
+
+// Objective is an elite monster or building taken by a team
+type Objective struct {
+	// Type is one of the Objective constants
+	Type string
+	// TeamID is the team that took the objective
+	TeamID int
+	// KillerID is 0 when minions took down the building
+	KillerID int
+	// Timestamp is milliseconds since the game started
+	Timestamp int64
+	// DragonType is set for dragons, e.g. AIR_DRAGON or ELDER_DRAGON
+	DragonType string
+	// LaneType and TowerType are set for buildings, e.g. MID_LANE and OUTER_TURRET
+	LaneType  string
+	TowerType string
+	Position  MatchPositionDTO
+}
+
+// ObjectiveTimeline lists the objectives of a match, oldest first
+type ObjectiveTimeline []Objective
+
+// ObjectiveWinRate is how often teams meeting a condition on an objective won
+type ObjectiveWinRate struct {
+	Objective string
+	Games     int
+	Wins      int
+}
+
+// ObjectiveStats aggregates objective control against wins across matches
+type ObjectiveStats struct {
+	teams []objectiveRecord
+}
+
+// objectiveRecord is the objective control of one team in one match
+type objectiveRecord struct {
+	win    bool
+	counts map[string]int
+	enemy  map[string]int
+	firsts map[string]bool
+}
+
+// Time returns Timestamp as a duration
+func (o Objective) Time() time.Duration {
+	return time.Duration(o.Timestamp) * time.Millisecond
+}
+
+// Objectives extracts the objective timeline from ELITE_MONSTER_KILL and BUILDING_KILL events
+func (t *MatchTimelineDTO) Objectives() ObjectiveTimeline {
+	var objectives ObjectiveTimeline
+	for _, event := range t.Events() {
+		switch e := event.(type) {
+		case EliteMonsterKill:
+			o := Objective{
+				Type:      e.MonsterType,
+				TeamID:    e.Team(),
+				KillerID:  e.KillerID,
+				Timestamp: e.Timestamp,
+				Position:  e.Position,
+			}
+			if e.MonsterType == ObjectiveDragon {
+				o.DragonType = e.MonsterSubType
+			}
+			objectives = append(objectives, o)
+		case BuildingKill:
+			objectives = append(objectives, Objective{
+				Type:      e.BuildingType,
+				TeamID:    e.Team(),
+				KillerID:  e.KillerID,
+				Timestamp: e.Timestamp,
+				LaneType:  e.LaneType,
+				TowerType: e.TowerType,
+				Position:  e.Position,
+			})
+		}
+	}
+	return objectives
+}
+
+// ByTeam returns the objectives teamID took
+func (ot ObjectiveTimeline) ByTeam(teamID int) ObjectiveTimeline {
+	var filtered ObjectiveTimeline
+	for _, o := range ot {
+		if o.TeamID == teamID {
+			filtered = append(filtered, o)
+		}
+	}
+	return filtered
+}
+
+// ByType returns the objectives of objectiveType, one of the Objective constants
+func (ot ObjectiveTimeline) ByType(objectiveType string) ObjectiveTimeline {
+	var filtered ObjectiveTimeline
+	for _, o := range ot {
+		if o.Type == objectiveType {
+			filtered = append(filtered, o)
+		}
+	}
+	return filtered
+}
+
+// First returns the first objective of objectiveType, false when none was taken
+func (ot ObjectiveTimeline) First(objectiveType string) (Objective, bool) {
+	if filtered := ot.ByType(objectiveType); len(filtered) > 0 {
+		return filtered[0], true
+	}
+	return Objective{}, false
+}
+
+// Count returns how many objectives of objectiveType teamID took
+func (ot ObjectiveTimeline) Count(teamID int, objectiveType string) int {
+	return len(ot.ByTeam(teamID).ByType(objectiveType))
+}
+
+// Verify checks the timeline against the TeamStatsDTO totals of match. Objectives taken after
+// the last timeline frame are missing from the timeline, so a mismatch is possible for them.
+func (ot ObjectiveTimeline) Verify(match *MatchDTO) error {
+	for _, team := range match.Teams {
+		totals := []struct {
+			objective string
+			expected  int
+		}{
+			{ObjectiveDragon, team.DragonKills},
+			{ObjectiveBaron, team.BaronKills},
+			{ObjectiveRiftHerald, team.RiftHeraldKills},
+			{ObjectiveTower, team.TowerKills},
+			{ObjectiveInhibitor, team.InhibitorKills},
+		}
+		for _, total := range totals {
+			if actual := ot.Count(team.TeamID, total.objective); actual != total.expected {
+				return fmt.Errorf("lol: team %d took %d %s in the timeline but %d in the match", team.TeamID, actual, total.objective, total.expected)
+			}
+		}
+
+		firsts := []struct {
+			objective string
+			expected  bool
+		}{
+			{ObjectiveDragon, team.FirstDragon},
+			{ObjectiveBaron, team.FirstBaron},
+			{ObjectiveRiftHerald, team.FirstRiftHerald},
+			{ObjectiveTower, team.FirstTower},
+			{ObjectiveInhibitor, team.FirstInhibitor},
+		}
+		for _, first := range firsts {
+			o, ok := ot.First(first.objective)
+			if actual := ok && o.TeamID == team.TeamID; actual != first.expected {
+				return fmt.Errorf("lol: team %d first %s is %t in the timeline but %t in the match", team.TeamID, first.objective, actual, first.expected)
+			}
+		}
+	}
+	return nil
+}
+
+// NewObjectiveStats returns an empty aggregate
+func NewObjectiveStats() *ObjectiveStats {
+	return &ObjectiveStats{}
+}
+
+// Add counts the objectives of both teams of match
+func (s *ObjectiveStats) Add(match *MatchDTO, timeline *MatchTimelineDTO) {
+	objectives := timeline.Objectives()
+	firsts := map[string]int{}
+	for _, o := range objectives {
+		if _, ok := firsts[o.Type]; !ok {
+			firsts[o.Type] = o.TeamID
+		}
+	}
+	for _, team := range match.Teams {
+		r := objectiveRecord{
+			win:    team.Win == "Win",
+			counts: map[string]int{},
+			enemy:  map[string]int{},
+			firsts: map[string]bool{},
+		}
+		for _, o := range objectives {
+			if o.TeamID == team.TeamID {
+				r.counts[o.Type]++
+			} else {
+				r.enemy[o.Type]++
+			}
+		}
+		for objective, teamID := range firsts {
+			r.firsts[objective] = teamID == team.TeamID
+		}
+		s.teams = append(s.teams, r)
+	}
+}
+
+// First returns the win rate of teams that took the first objective of objectiveType
+func (s *ObjectiveStats) First(objectiveType string) ObjectiveWinRate {
+	return s.winRate(objectiveType, func(r objectiveRecord) bool { return r.firsts[objectiveType] })
+}
+
+// Majority returns the win rate of teams that took more objectives of objectiveType than their enemy
+func (s *ObjectiveStats) Majority(objectiveType string) ObjectiveWinRate {
+	return s.winRate(objectiveType, func(r objectiveRecord) bool { return r.counts[objectiveType] > r.enemy[objectiveType] })
+}
+
+// AtLeast returns the win rate of teams that took n or more objectives of objectiveType
+func (s *ObjectiveStats) AtLeast(objectiveType string, n int) ObjectiveWinRate {
+	return s.winRate(objectiveType, func(r objectiveRecord) bool { return r.counts[objectiveType] >= n })
+}
+
+func (s *ObjectiveStats) winRate(objectiveType string, match func(objectiveRecord) bool) ObjectiveWinRate {
+	rate := ObjectiveWinRate{Objective: objectiveType}
+	for _, r := range s.teams {
+		if !match(r) {
+			continue
+		}
+		rate.Games++
+		if r.win {
+			rate.Wins++
+		}
+	}
+	return rate
+}
+
+// WinRate returns the fraction of games won
+func (w ObjectiveWinRate) WinRate() float64 {
+	return ratio(w.Wins, w.Games)
+}
@@ -0,0 +1,99 @@
+package lol
+
+import (
+	"log"
+	"net/http"
+	"testing"
+
+	"github.com/dnaeon/go-vcr/recorder"
+)
+
+func TestObjectives(t *testing.T) {
+	rec, err := recorder.New("cassettes/lol/match-v4/matches")
+	if err != nil {
+		log.Fatal(err)
+	} else {
+		rec.SetMatcher(matchWithoutToken)
+		httpClient = &http.Client{Transport: rec}
+	}
+	rec.AddFilter(removeToken)
+	defer rec.Stop()
+	cli, err := NewClient(testToken, WithHTTPClient(httpClient))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	match, resp, err := cli.Matches(matchID)
+	if resp.StatusCode != 200 {
+		t.Errorf("\nExpected: 200 status code\nActual: %d status code", resp.StatusCode)
+		return
+	}
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	timelineRec, err := recorder.New("cassettes/lol/match-v4/timelines")
+	if err != nil {
+		log.Fatal(err)
+	}
+	timelineRec.SetMatcher(matchWithoutToken)
+	timelineRec.AddFilter(removeToken)
+	defer timelineRec.Stop()
+	cli, err = NewClient(testToken, WithHTTPClient(&http.Client{Transport: timelineRec}))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	tl, _, err := cli.Timelines(matchID)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	objectives := tl.Objectives()
+	if expected, actual := 21, len(objectives); expected != actual {
+		t.Errorf("\nExpected: %d\nActual: %d\n", expected, actual)
+		return
+	}
+	dragon, ok := objectives.First(ObjectiveDragon)
+	if !ok || dragon.TeamID != 100 || dragon.DragonType != "AIR_DRAGON" || dragon.Timestamp != 373896 {
+		t.Errorf("\nExpected: team 100 air dragon at 373896ms\nActual: %+v\n", dragon)
+		return
+	}
+	// the first tower fell to team 100 bot lane
+	tower, ok := objectives.First(ObjectiveTower)
+	if !ok || tower.TeamID != 100 || tower.LaneType != "BOT_LANE" || tower.TowerType != "OUTER_TURRET" {
+		t.Errorf("\nExpected: team 100 bot outer turret\nActual: %+v\n", tower)
+		return
+	}
+	if err := objectives.Verify(match); err != nil {
+		t.Error(err)
+		return
+	}
+	match.Teams[1].DragonKills++
+	if err := objectives.Verify(match); err == nil {
+		t.Error("\nExpected: dragon kills mismatch\nActual: nil")
+		return
+	}
+	match.Teams[1].DragonKills--
+
+	stats := NewObjectiveStats()
+	stats.Add(match, tl)
+	tests := []struct {
+		expected ObjectiveWinRate
+		actual   ObjectiveWinRate
+	}{
+		{ObjectiveWinRate{ObjectiveDragon, 1, 1}, stats.First(ObjectiveDragon)},
+		{ObjectiveWinRate{ObjectiveTower, 1, 1}, stats.Majority(ObjectiveTower)},
+		{ObjectiveWinRate{ObjectiveDragon, 2, 1}, stats.AtLeast(ObjectiveDragon, 2)},
+		{ObjectiveWinRate{ObjectiveBaron, 0, 0}, stats.AtLeast(ObjectiveBaron, 2)},
+	}
+	for _, test := range tests {
+		if test.expected != test.actual {
+			t.Errorf("\nExpected: %+v\nActual: %+v\n", test.expected, test.actual)
+			return
+		}
+	}
+}
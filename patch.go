@@ -0,0 +1,199 @@
+package lol
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Patch is a League of Legends patch like 9.22, parsed from GameVersion or a Data Dragon version
+type Patch struct {
+	Major int
+	Minor int
+}
+
+// PatchCalendar maps patches to the day they were released
+type PatchCalendar map[Patch]time.Time
+
+// maxPatchLifetime is how long the latest patch of a calendar is assumed to stay live, no patch
+// has lasted longer. Past it the calendar is missing newer patches.
+const maxPatchLifetime = 5 * 7 * 24 * time.Hour
+
+// DefaultPatchCalendar holds the release day of the patches 9.1 to 10.10, in UTC. PatchAt and
+// Range know nothing past 10.10, add newer patches to it, or build a PatchCalendar of your own,
+// to query later patches.
+var DefaultPatchCalendar = PatchCalendar{
+	{9, 1}:   patchDate("2019-01-09"),
+	{9, 2}:   patchDate("2019-01-23"),
+	{9, 3}:   patchDate("2019-02-06"),
+	{9, 4}:   patchDate("2019-02-20"),
+	{9, 5}:   patchDate("2019-03-06"),
+	{9, 6}:   patchDate("2019-03-20"),
+	{9, 7}:   patchDate("2019-04-03"),
+	{9, 8}:   patchDate("2019-04-17"),
+	{9, 9}:   patchDate("2019-05-01"),
+	{9, 10}:  patchDate("2019-05-15"),
+	{9, 11}:  patchDate("2019-05-29"),
+	{9, 12}:  patchDate("2019-06-12"),
+	{9, 13}:  patchDate("2019-06-26"),
+	{9, 14}:  patchDate("2019-07-10"),
+	{9, 15}:  patchDate("2019-07-24"),
+	{9, 16}:  patchDate("2019-08-07"),
+	{9, 17}:  patchDate("2019-08-21"),
+	{9, 18}:  patchDate("2019-09-11"),
+	{9, 19}:  patchDate("2019-09-25"),
+	{9, 20}:  patchDate("2019-10-09"),
+	{9, 21}:  patchDate("2019-10-23"),
+	{9, 22}:  patchDate("2019-11-06"),
+	{9, 23}:  patchDate("2019-11-20"),
+	{9, 24}:  patchDate("2019-12-10"),
+	{10, 1}:  patchDate("2020-01-08"),
+	{10, 2}:  patchDate("2020-01-23"),
+	{10, 3}:  patchDate("2020-02-05"),
+	{10, 4}:  patchDate("2020-02-20"),
+	{10, 5}:  patchDate("2020-03-04"),
+	{10, 6}:  patchDate("2020-03-18"),
+	{10, 7}:  patchDate("2020-04-01"),
+	{10, 8}:  patchDate("2020-04-15"),
+	{10, 9}:  patchDate("2020-04-29"),
+	{10, 10}: patchDate("2020-05-13"),
+}
+
+func patchDate(day string) time.Time {
+	t, err := time.Parse("2006-01-02", day)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+// ParsePatch parses the major and minor version of a game version like 9.22.296.1984
+// or a Data Dragon version like 9.22.1
+func ParsePatch(version string) (Patch, error) {
+	parts := strings.SplitN(version, ".", 3)
+	if len(parts) < 2 {
+		return Patch{}, fmt.Errorf("lol: invalid patch %q", version)
+	}
+	major, err := strconv.Atoi(parts[0])
+	if err != nil || major < 0 {
+		return Patch{}, fmt.Errorf("lol: invalid patch %q", version)
+	}
+	minor, err := strconv.Atoi(parts[1])
+	if err != nil || minor < 0 {
+		return Patch{}, fmt.Errorf("lol: invalid patch %q", version)
+	}
+	return Patch{Major: major, Minor: minor}, nil
+}
+
+// String returns the patch as shown in patch notes, e.g. 9.22
+func (p Patch) String() string {
+	return strconv.Itoa(p.Major) + "." + strconv.Itoa(p.Minor)
+}
+
+// Compare returns -1, 0 or 1 when p is older than, the same as or newer than o
+func (p Patch) Compare(o Patch) int {
+	switch {
+	case p.Major < o.Major || p.Major == o.Major && p.Minor < o.Minor:
+		return -1
+	case p == o:
+		return 0
+	default:
+		return 1
+	}
+}
+
+// Before reports whether p is older than o
+func (p Patch) Before(o Patch) bool {
+	return p.Compare(o) < 0
+}
+
+// After reports whether p is newer than o
+func (p Patch) After(o Patch) bool {
+	return p.Compare(o) > 0
+}
+
+// DataDragonVersion returns the newest of versions, as returned by DataDragon.Versions, that
+// belongs to p. Most patches only have X.Y.1 but hotfixes add e.g. 9.7.2.
+func (p Patch) DataDragonVersion(versions []string) (string, bool) {
+	best := ""
+	for _, v := range versions {
+		vp, err := ParsePatch(v)
+		if err != nil || vp != p {
+			continue
+		}
+		if best == "" || compareVersions(v, best) > 0 {
+			best = v
+		}
+	}
+	return best, best != ""
+}
+
+// Patch parses GameVersion
+func (m *MatchDTO) Patch() (Patch, error) {
+	return ParsePatch(m.GameVersion)
+}
+
+// Patch parses GameVersion
+func (i *MatchInfoV5DTO) Patch() (Patch, error) {
+	return ParsePatch(i.GameVersion)
+}
+
+// Release returns the day p was released
+func (c PatchCalendar) Release(p Patch) (time.Time, bool) {
+	t, ok := c[p]
+	return t, ok
+}
+
+// Patches returns the patches in the calendar oldest first
+func (c PatchCalendar) Patches() []Patch {
+	patches := make([]Patch, 0, len(c))
+	for p := range c {
+		patches = append(patches, p)
+	}
+	sort.Slice(patches, func(i, j int) bool { return patches[i].Before(patches[j]) })
+	return patches
+}
+
+// PatchAt returns the patch that was live at t, false before the first patch of the calendar and
+// once the latest one is older than the longest a patch stays live
+func (c PatchCalendar) PatchAt(t time.Time) (Patch, bool) {
+	var found Patch
+	ok := false
+	for _, p := range c.Patches() {
+		if t.Before(c[p]) {
+			return found, ok
+		}
+		found, ok = p, true
+	}
+	if ok && !t.Before(c[found].Add(maxPatchLifetime)) {
+		return Patch{}, false
+	}
+	return found, ok
+}
+
+// Range returns the time span from the release of from until the release of the patch after to.
+// end is the zero time when to is the latest patch in the calendar and may still be live, when
+// it cannot be the calendar is missing newer patches and an error is returned.
+func (c PatchCalendar) Range(from, to Patch) (begin, end time.Time, err error) {
+	if to.Before(from) {
+		return begin, end, fmt.Errorf("lol: patch %s is before %s", to, from)
+	}
+	begin, ok := c[from]
+	if !ok {
+		return begin, end, fmt.Errorf("lol: release of patch %s is unknown", from)
+	}
+	if _, ok := c[to]; !ok {
+		return begin, end, fmt.Errorf("lol: release of patch %s is unknown", to)
+	}
+	for _, p := range c.Patches() {
+		if p.After(to) {
+			return begin, c[p], nil
+		}
+	}
+	if time.Since(c[to]) >= maxPatchLifetime {
+		return begin, end, fmt.Errorf("lol: patch %s is the latest known, the patches after it are missing", to)
+	}
+	return begin, time.Time{}, nil
+}
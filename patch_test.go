@@ -0,0 +1,102 @@
+package lol
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParsePatch(t *testing.T) {
+	tests := []struct {
+		version  string
+		expected Patch
+	}{
+		{"9.22.296.1984", Patch{9, 22}},
+		{"9.7.2", Patch{9, 7}},
+		{"10.1", Patch{10, 1}},
+	}
+	for _, test := range tests {
+		actual, err := ParsePatch(test.version)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if test.expected != actual {
+			t.Errorf("\nExpected: %v\nActual: %v\n", test.expected, actual)
+			return
+		}
+	}
+	for _, version := range []string{"", "9", "lolpatch_3.7", "9.x.1"} {
+		if _, err := ParsePatch(version); err == nil {
+			t.Errorf("\nExpected: error for %q\nActual: nil\n", version)
+			return
+		}
+	}
+}
+
+func TestPatchCompare(t *testing.T) {
+	if !(Patch{9, 9}).Before(Patch{9, 10}) || !(Patch{10, 1}).After(Patch{9, 24}) || (Patch{9, 22}).Compare(Patch{9, 22}) != 0 {
+		t.Error("\nExpected: 9.9 < 9.10 < 9.24 < 10.1\nActual: otherwise")
+	}
+}
+
+func TestPatchDataDragonVersion(t *testing.T) {
+	versions := []string{"9.8.1", "9.7.2", "9.7.1", "9.6.1"}
+	expected := "9.7.2"
+	actual, ok := Patch{9, 7}.DataDragonVersion(versions)
+	if !ok || expected != actual {
+		t.Errorf("\nExpected: %s\nActual: %s\n", expected, actual)
+		return
+	}
+	if _, ok := (Patch{9, 9}).DataDragonVersion(versions); ok {
+		t.Error("\nExpected: no version for 9.9\nActual: found one")
+	}
+}
+
+func TestPatchCalendar(t *testing.T) {
+	p, ok := DefaultPatchCalendar.PatchAt(time.Date(2019, 11, 7, 12, 0, 0, 0, time.UTC))
+	if expected := (Patch{9, 22}); !ok || expected != p {
+		t.Errorf("\nExpected: %v\nActual: %v\n", expected, p)
+		return
+	}
+	begin, end, err := DefaultPatchCalendar.Range(Patch{9, 21}, Patch{9, 22})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if expected, actual := 28*24*time.Hour, end.Sub(begin); expected != actual {
+		t.Errorf("\nExpected: %v\nActual: %v\n", expected, actual)
+		return
+	}
+	if _, _, err := DefaultPatchCalendar.Range(Patch{9, 22}, Patch{9, 21}); err == nil {
+		t.Error("\nExpected: error for reversed range\nActual: nil")
+	}
+}
+
+func TestPatchCalendarBounds(t *testing.T) {
+	if p, ok := DefaultPatchCalendar.PatchAt(time.Date(2020, 5, 20, 0, 0, 0, 0, time.UTC)); !ok || p != (Patch{10, 10}) {
+		t.Errorf("\nExpected: %v\nActual: %v\n", Patch{10, 10}, p)
+		return
+	}
+	if p, ok := DefaultPatchCalendar.PatchAt(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)); ok {
+		t.Errorf("\nExpected: no patch known in 2021\nActual: %v\n", p)
+		return
+	}
+	if _, ok := DefaultPatchCalendar.PatchAt(time.Date(2018, 12, 1, 0, 0, 0, 0, time.UTC)); ok {
+		t.Error("\nExpected: no patch before 9.1\nActual: found one")
+		return
+	}
+	if _, _, err := DefaultPatchCalendar.Range(Patch{10, 9}, Patch{10, 10}); err == nil {
+		t.Error("\nExpected: error for a range through the outdated latest patch\nActual: nil")
+		return
+	}
+
+	calendar := PatchCalendar{{9, 1}: time.Now().Add(-24 * time.Hour)}
+	_, end, err := calendar.Range(Patch{9, 1}, Patch{9, 1})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if !end.IsZero() {
+		t.Errorf("\nExpected: open range for the live patch\nActual: %v\n", end)
+	}
+}
@@ -0,0 +1,286 @@
+package lol
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dghubble/sling"
+)
+
+const registryDocsURL = "https://static.developer.riotgames.com/docs/lol/"
+
+// Queue is a queueId as used by MatchDTO.QueueID, MatchReferenceDTO.Queue and
+// CurrentGameInfo.GameQueueConfigID
+type Queue int
+
+// Queues that are still played, see Registry for the full list
+const (
+	QueueCustom           Queue = 0
+	QueueNormalDraft      Queue = 400
+	QueueRankedSolo       Queue = 420
+	QueueNormalBlind      Queue = 430
+	QueueRankedFlex       Queue = 440
+	QueueARAM             Queue = 450
+	QueueTTBlind          Queue = 460
+	QueueTTRankedFlex     Queue = 470
+	QueueClash            Queue = 700
+	QueueCoopIntro        Queue = 830
+	QueueCoopBeginner     Queue = 840
+	QueueCoopIntermediate Queue = 850
+	QueueURF              Queue = 900
+	QueueOneForAll        Queue = 1020
+	QueueTFTNormal        Queue = 1090
+	QueueTFTRanked        Queue = 1100
+	QueueTFTTutorial      Queue = 1110
+)
+
+// Map IDs as used by MatchDTO.MapID and CurrentGameInfo.MapID
+const (
+	MapIDTwistedTreeline = 10
+	MapIDSummonersRift   = 11
+	MapIDHowlingAbyss    = 12
+	MapIDNexusBlitz      = 21
+	MapIDConvergence     = 22
+)
+
+// rankedQueues holds every queue that awards league points, current or deprecated
+var rankedQueues = map[Queue]bool{
+	4: true, 6: true, 9: true, 41: true, 42: true, 410: true,
+	QueueRankedSolo: true, QueueRankedFlex: true, QueueTTRankedFlex: true, QueueTFTRanked: true,
+}
+
+// seasonStarts is when each season of seasons.json began, the document itself has no dates.
+// Preseasons start with the preseason patch and seasons with the first ranked split.
+var seasonStarts = map[int]string{
+	0:  "2012-11-13",
+	1:  "2013-02-01",
+	2:  "2013-11-13",
+	3:  "2014-01-16",
+	4:  "2014-11-20",
+	5:  "2015-01-21",
+	6:  "2015-11-10",
+	7:  "2016-01-20",
+	8:  "2016-11-09",
+	9:  "2017-02-08",
+	10: "2017-11-08",
+	11: "2018-01-16",
+	12: "2018-11-12",
+	13: "2019-01-23",
+}
+
+// seasonsEnd is when the last season of seasonStarts ended, every other season ends when the
+// next one starts. SeasonAt knows no season after it.
+const seasonsEnd = "2019-11-20"
+
+// IsRanked reports whether q awards league points
+func (q Queue) IsRanked() bool {
+	return rankedQueues[q]
+}
+
+// IsRankedSolo reports whether q is 5v5 ranked solo/duo, including the deprecated queue 4
+func (q Queue) IsRankedSolo() bool {
+	return q == QueueRankedSolo || q == 4
+}
+
+// IsTFT reports whether q is a Teamfight Tactics queue
+func (q Queue) IsTFT() bool {
+	return q == QueueTFTNormal || q == QueueTFTRanked || q == QueueTFTTutorial
+}
+
+type QueueMetadata struct {
+	QueueID     Queue  `json:"queueId"`
+	Map         string `json:"map"`
+	Description string `json:"description"`
+	Notes       string `json:"notes"`
+}
+
+// Deprecated reports whether Riot marked the queue as deprecated
+func (q QueueMetadata) Deprecated() bool {
+	return strings.Contains(strings.ToLower(q.Notes), "deprecated")
+}
+
+type MapMetadata struct {
+	MapID   int    `json:"mapId"`
+	MapName string `json:"mapName"`
+	Notes   string `json:"notes"`
+}
+
+type SeasonMetadata struct {
+	ID     int    `json:"id"`
+	Season string `json:"season"`
+	// Start and End are zero for seasons newer than the embedded snapshot, End is exclusive
+	Start time.Time `json:"-"`
+	End   time.Time `json:"-"`
+}
+
+type GameModeMetadata struct {
+	GameMode    string `json:"gameMode"`
+	Description string `json:"description"`
+}
+
+// Registry answers questions about queue, map, season and game mode IDs.
+// It is safe for concurrent use, including while Refresh runs.
+type Registry struct {
+	mu        sync.RWMutex
+	queues    map[Queue]QueueMetadata
+	maps      map[int]MapMetadata
+	seasons   []SeasonMetadata
+	gameModes map[string]GameModeMetadata
+}
+
+// NewRegistry returns a Registry loaded from the snapshot embedded in the library
+func NewRegistry() *Registry {
+	r := &Registry{}
+	err := r.load(
+		[]byte(registryQueuesJSON),
+		[]byte(registryMapsJSON),
+		[]byte(registrySeasonsJSON),
+		[]byte(registryGameModesJSON),
+	)
+	if err != nil {
+		panic("lol: embedded registry data is invalid: " + err.Error())
+	}
+	return r
+}
+
+// Refresh replaces the registry contents with the current documents from
+// static.developer.riotgames.com. A nil httpClient uses DefaultHTTPClient.
+// The registry is left untouched when any document fails to load.
+func (r *Registry) Refresh(httpClient *http.Client) error {
+	if httpClient == nil {
+		httpClient = DefaultHTTPClient
+	}
+	s := sling.New().Client(httpClient).Base(registryDocsURL).Set("User-Agent", "jonwho/lol")
+	docs := make([][]byte, 4)
+	for i, name := range []string{"queues.json", "maps.json", "seasons.json", "gameModes.json"} {
+		raw := new(json.RawMessage)
+		resp, err := s.New().Get(name).Receive(raw, nil)
+		if err != nil {
+			return err
+		}
+		if resp.StatusCode != http.StatusOK {
+			return fmt.Errorf("lol: registry request for %s failed: %s", name, resp.Status)
+		}
+		docs[i] = *raw
+	}
+	return r.load(docs[0], docs[1], docs[2], docs[3])
+}
+
+func (r *Registry) load(queuesJSON, mapsJSON, seasonsJSON, gameModesJSON []byte) error {
+	var queues []QueueMetadata
+	var maps []MapMetadata
+	var seasons []SeasonMetadata
+	var gameModes []GameModeMetadata
+	if err := json.Unmarshal(queuesJSON, &queues); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(mapsJSON, &maps); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(seasonsJSON, &seasons); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(gameModesJSON, &gameModes); err != nil {
+		return err
+	}
+
+	queueIndex := make(map[Queue]QueueMetadata, len(queues))
+	for _, q := range queues {
+		queueIndex[q.QueueID] = q
+	}
+	// a map ID is listed once per variant, the last entry is the current one
+	mapIndex := make(map[int]MapMetadata, len(maps))
+	for _, m := range maps {
+		mapIndex[m.MapID] = m
+	}
+	for i := range seasons {
+		if start, ok := seasonStarts[seasons[i].ID]; ok {
+			end, ok := seasonStarts[seasons[i].ID+1]
+			if !ok {
+				end = seasonsEnd
+			}
+			seasons[i].Start, _ = time.Parse("2006-01-02", start)
+			seasons[i].End, _ = time.Parse("2006-01-02", end)
+		}
+	}
+	sort.Slice(seasons, func(i, j int) bool { return seasons[i].ID < seasons[j].ID })
+	modeIndex := make(map[string]GameModeMetadata, len(gameModes))
+	for _, g := range gameModes {
+		modeIndex[g.GameMode] = g
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.queues, r.maps, r.seasons, r.gameModes = queueIndex, mapIndex, seasons, modeIndex
+	return nil
+}
+
+// Queue returns the metadata of queueID
+func (r *Registry) Queue(queueID Queue) (QueueMetadata, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	q, ok := r.queues[queueID]
+	return q, ok
+}
+
+// Queues returns every known queue ordered by ID
+func (r *Registry) Queues() []QueueMetadata {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	queues := make([]QueueMetadata, 0, len(r.queues))
+	for _, q := range r.queues {
+		queues = append(queues, q)
+	}
+	sort.Slice(queues, func(i, j int) bool { return queues[i].QueueID < queues[j].QueueID })
+	return queues
+}
+
+// Map returns the metadata of mapID
+func (r *Registry) Map(mapID int) (MapMetadata, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	m, ok := r.maps[mapID]
+	return m, ok
+}
+
+// GameMode returns the metadata of gameMode, e.g. CLASSIC
+func (r *Registry) GameMode(gameMode string) (GameModeMetadata, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	g, ok := r.gameModes[strings.ToUpper(gameMode)]
+	return g, ok
+}
+
+// Season returns the metadata of seasonID
+func (r *Registry) Season(seasonID int) (SeasonMetadata, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, s := range r.seasons {
+		if s.ID == seasonID {
+			return s, true
+		}
+	}
+	return SeasonMetadata{}, false
+}
+
+// SeasonAt returns the season that was running at t, false outside the seasons with known dates
+func (r *Registry) SeasonAt(t time.Time) (SeasonMetadata, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, s := range r.seasons {
+		if !s.Start.IsZero() && !t.Before(s.Start) && t.Before(s.End) {
+			return s, true
+		}
+	}
+	return SeasonMetadata{}, false
+}
+
+// SeasonAtMillis is SeasonAt for epoch milliseconds like MatchDTO.GameCreation
+func (r *Registry) SeasonAtMillis(epochMillis int64) (SeasonMetadata, bool) {
+	return r.SeasonAt(time.Unix(0, epochMillis*int64(time.Millisecond)))
+}
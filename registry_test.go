@@ -0,0 +1,135 @@
+package lol
+
+import (
+	"log"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/dghubble/sling"
+	"github.com/dnaeon/go-vcr/recorder"
+)
+
+func TestRegistry(t *testing.T) {
+	r := NewRegistry()
+
+	q, ok := r.Queue(QueueRankedSolo)
+	if !ok || !q.QueueID.IsRankedSolo() || !q.QueueID.IsRanked() {
+		t.Errorf("\nExpected: queue 420 to be ranked solo\nActual: %v\n", q)
+		return
+	}
+	if expected, actual := "5v5 Ranked Solo games", q.Description; expected != actual {
+		t.Errorf("\nExpected: %s\nActual: %s\n", expected, actual)
+		return
+	}
+	if q, _ := r.Queue(65); !q.Deprecated() {
+		t.Errorf("\nExpected: queue 65 to be deprecated\nActual: %v\n", q)
+		return
+	}
+	if QueueNormalDraft.IsRanked() || !QueueTFTRanked.IsTFT() {
+		t.Error("\nExpected: 400 unranked and 1100 TFT\nActual: otherwise")
+		return
+	}
+
+	m, _ := r.Map(MapIDSummonersRift)
+	if expected, actual := "Summoner's Rift", m.MapName; expected != actual {
+		t.Errorf("\nExpected: %s\nActual: %s\n", expected, actual)
+		return
+	}
+	g, _ := r.GameMode("aram")
+	if expected, actual := "ARAM games", g.Description; expected != actual {
+		t.Errorf("\nExpected: %s\nActual: %s\n", expected, actual)
+		return
+	}
+
+	s, _ := r.SeasonAt(time.Date(2018, 6, 1, 0, 0, 0, 0, time.UTC))
+	if expected, actual := "SEASON 2018", s.Season; expected != actual {
+		t.Errorf("\nExpected: %s\nActual: %s\n", expected, actual)
+		return
+	}
+	if _, ok := r.SeasonAt(time.Date(2010, 1, 1, 0, 0, 0, 0, time.UTC)); ok {
+		t.Error("\nExpected: no season in 2010\nActual: found one")
+		return
+	}
+	s, _ = r.SeasonAt(time.Date(2019, 11, 19, 0, 0, 0, 0, time.UTC))
+	if expected, actual := "SEASON 2019", s.Season; expected != actual {
+		t.Errorf("\nExpected: %s\nActual: %s\n", expected, actual)
+		return
+	}
+	if s, ok := r.SeasonAt(time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC)); ok {
+		t.Errorf("\nExpected: no season known in 2020\nActual: %s\n", s.Season)
+		return
+	}
+}
+
+func TestRegistrySeasonOfMatch(t *testing.T) {
+	rec, err := recorder.New("cassettes/lol/match-v4/matches")
+	if err != nil {
+		log.Fatal(err)
+	} else {
+		rec.SetMatcher(matchWithoutToken)
+		httpClient = &http.Client{Transport: rec}
+	}
+	rec.AddFilter(removeToken)
+	defer rec.Stop()
+	cli, err := NewClient(testToken, WithHTTPClient(httpClient))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	dto, resp, err := cli.Matches(matchID)
+	if resp.StatusCode != 200 {
+		t.Errorf("\nExpected: 200 status code\nActual: %d status code", resp.StatusCode)
+		return
+	}
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	season, _ := NewRegistry().SeasonAtMillis(dto.GameCreation)
+	expected := dto.SeasonID
+	actual := season.ID
+	if expected != actual {
+		t.Errorf("\nExpected: %d\nActual: %d\n", expected, actual)
+		return
+	}
+}
+
+func TestRegistryRefresh(t *testing.T) {
+	rec, err := recorder.New("cassettes/registry/refresh")
+	if err != nil {
+		log.Fatal(err)
+	}
+	rec.SetMatcher(matchWithoutToken)
+	defer rec.Stop()
+
+	r := NewRegistry()
+	if err := r.Refresh(&http.Client{Transport: rec}); err != nil {
+		t.Error(err)
+		return
+	}
+	if _, ok := r.Queue(1300); !ok {
+		t.Error("\nExpected: queue 1300 after refresh\nActual: missing")
+		return
+	}
+	s, ok := r.Season(14)
+	if !ok || !s.Start.IsZero() {
+		t.Errorf("\nExpected: season 14 without a start date\nActual: %v\n", s)
+		return
+	}
+}
+
+func TestMatchlistsParamsQueue(t *testing.T) {
+	req, err := sling.New().Base("https://na1.api.riotgames.com/").QueryStruct(&MatchlistsParams{Queue: []Queue{QueueRankedSolo, QueueRankedFlex}}).Request()
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	expected := []string{"420", "440"}
+	actual := req.URL.Query()["queue"]
+	if len(actual) != 2 || expected[0] != actual[0] || expected[1] != actual[1] {
+		t.Errorf("\nExpected: %v\nActual: %v\n", expected, actual)
+		return
+	}
+}
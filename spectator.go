@@ -0,0 +1,99 @@
+package lol
+
+import (
+	"net/http"
+)
+
+// GameModeTFT is the game mode spectator-v5 reports for TFT games, used to tell them from League ones
+const GameModeTFT = "TFT"
+
+// ActiveGamesByPUUID GET /lol/spectator/v5/active-games/by-summoner/{encryptedPUUID}
+func (l *LOL) ActiveGamesByPUUID(encryptedPUUID string) (*CurrentGameInfo, *http.Response, error) {
+	info := new(CurrentGameInfo)
+	var reqErr error
+	resp, err := l.sling.New().Get("spectator/v5/active-games/by-summoner/"+encryptedPUUID).Receive(info, reqErr)
+	if err != nil {
+		return nil, resp, err
+	}
+	return info, resp, reqErr
+}
+
+// FeaturedGamesV5 GET /lol/spectator/v5/featured-games
+func (l *LOL) FeaturedGamesV5() (*FeaturedGames, *http.Response, error) {
+	info := new(FeaturedGames)
+	var reqErr error
+	resp, err := l.sling.New().Get("spectator/v5/featured-games").Receive(info, reqErr)
+	if err != nil {
+		return nil, resp, err
+	}
+	return info, resp, reqErr
+}
+
+// ActiveGamesByPUUID GET /lol/spectator/tft/v5/active-games/by-puuid/{encryptedPUUID}
+func (t *TFT) ActiveGamesByPUUID(encryptedPUUID string) (*CurrentGameInfo, *http.Response, error) {
+	info := new(CurrentGameInfo)
+	var reqErr error
+	resp, err := t.spectator.New().Get("v5/active-games/by-puuid/"+encryptedPUUID).Receive(info, reqErr)
+	if err != nil {
+		return nil, resp, err
+	}
+	return info, resp, reqErr
+}
+
+// FeaturedGamesV5 GET /lol/spectator/tft/v5/featured-games
+func (t *TFT) FeaturedGamesV5() (*FeaturedGames, *http.Response, error) {
+	info := new(FeaturedGames)
+	var reqErr error
+	resp, err := t.spectator.New().Get("v5/featured-games").Receive(info, reqErr)
+	if err != nil {
+		return nil, resp, err
+	}
+	return info, resp, reqErr
+}
+
+// IsTFT reports whether the live game is a Teamfight Tactics game
+func (g *CurrentGameInfo) IsTFT() bool {
+	return g.GameMode == GameModeTFT
+}
+
+// Participant returns the participant with the given PUUID or nil when they are not in the game
+func (g *CurrentGameInfo) Participant(puuid string) *CurrentGameParticipant {
+	for i := range g.Participants {
+		if g.Participants[i].Puuid == puuid {
+			return &g.Participants[i]
+		}
+	}
+	return nil
+}
+
+// Team returns the participants on teamID in draft order.
+// Every TFT player is on their own team, so overlays usually list Participants instead.
+func (g *CurrentGameInfo) Team(teamID int64) []CurrentGameParticipant {
+	var team []CurrentGameParticipant
+	for _, p := range g.Participants {
+		if p.TeamID == teamID {
+			team = append(team, p)
+		}
+	}
+	return team
+}
+
+// Bans returns the champions banned by teamID
+func (g *CurrentGameInfo) Bans(teamID int64) []BannedChampion {
+	var bans []BannedChampion
+	for _, b := range g.BannedChampions {
+		if b.TeamID == teamID {
+			bans = append(bans, b)
+		}
+	}
+	return bans
+}
+
+// Elapsed returns the in-game time in seconds at unixMillis, gameLength is only a snapshot
+// taken when the response was generated.
+func (g *CurrentGameInfo) Elapsed(unixMillis int64) int64 {
+	if g.GameStartTime == 0 || unixMillis < g.GameStartTime {
+		return g.GameLength
+	}
+	return (unixMillis - g.GameStartTime) / 1000
+}
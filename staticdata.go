@@ -0,0 +1,237 @@
+package lol
+
+import (
+	"strconv"
+)
+
+// StaticData indexes Data Dragon champions, items, runes and summoner spells by the numeric IDs
+// used in API responses. Lookups return nil for unknown IDs, e.g. an empty item slot.
+type StaticData struct {
+	Version   string
+	champions map[int64]*Champion
+	items     map[int64]*Item
+	runes     map[int64]*Rune
+	runeTrees map[int64]*RuneTree
+	spells    map[int64]*SummonerSpell
+}
+
+// LoadStaticData loads and indexes the static data of dd's version and locale
+func LoadStaticData(dd *DataDragon) (*StaticData, error) {
+	champions, err := dd.Champions()
+	if err != nil {
+		return nil, err
+	}
+	items, err := dd.Items()
+	if err != nil {
+		return nil, err
+	}
+	runes, err := dd.Runes()
+	if err != nil {
+		return nil, err
+	}
+	spells, err := dd.SummonerSpells()
+	if err != nil {
+		return nil, err
+	}
+	return NewStaticData(champions, items, runes, spells), nil
+}
+
+// NewStaticData indexes already loaded static data, any of the arguments may be nil
+func NewStaticData(champions *ChampionList, items *ItemList, runes []RuneTree, spells *SummonerSpellList) *StaticData {
+	data := &StaticData{
+		champions: map[int64]*Champion{},
+		items:     map[int64]*Item{},
+		runes:     map[int64]*Rune{},
+		runeTrees: map[int64]*RuneTree{},
+		spells:    map[int64]*SummonerSpell{},
+	}
+	if champions != nil {
+		data.Version = champions.Version
+		for _, c := range champions.Data {
+			c := c
+			if key, err := strconv.ParseInt(c.Key, 10, 64); err == nil {
+				data.champions[key] = &c
+			}
+		}
+	}
+	if items != nil {
+		for id, item := range items.Data {
+			item := item
+			if key, err := strconv.ParseInt(id, 10, 64); err == nil {
+				data.items[key] = &item
+			}
+		}
+	}
+	for i := range runes {
+		tree := &runes[i]
+		data.runeTrees[int64(tree.ID)] = tree
+		for j := range tree.Slots {
+			for k := range tree.Slots[j].Runes {
+				r := &tree.Slots[j].Runes[k]
+				data.runes[int64(r.ID)] = r
+			}
+		}
+	}
+	if spells != nil {
+		for _, s := range spells.Data {
+			s := s
+			if key, err := strconv.ParseInt(s.Key, 10, 64); err == nil {
+				data.spells[key] = &s
+			}
+		}
+	}
+	return data
+}
+
+// Champion returns the champion with the numeric championId used by the API
+func (data *StaticData) Champion(championID int64) *Champion {
+	return data.champions[championID]
+}
+
+// Item returns the item with itemID
+func (data *StaticData) Item(itemID int64) *Item {
+	return data.items[itemID]
+}
+
+// Rune returns the rune with perkID, stat shards are not runes and return nil
+func (data *StaticData) Rune(perkID int64) *Rune {
+	return data.runes[perkID]
+}
+
+// RuneTree returns the rune tree with styleID, e.g. 8000 for Precision
+func (data *StaticData) RuneTree(styleID int64) *RuneTree {
+	return data.runeTrees[styleID]
+}
+
+// SummonerSpell returns the summoner spell with the numeric spell ID used by the API
+func (data *StaticData) SummonerSpell(spellID int64) *SummonerSpell {
+	return data.spells[spellID]
+}
+
+// Keystone returns the rune with perkID when it sits in the keystone slot of its tree
+func (data *StaticData) Keystone(perkID int64) *Rune {
+	for _, tree := range data.runeTrees {
+		if len(tree.Slots) == 0 {
+			continue
+		}
+		for i := range tree.Slots[0].Runes {
+			if int64(tree.Slots[0].Runes[i].ID) == perkID {
+				return &tree.Slots[0].Runes[i]
+			}
+		}
+	}
+	return nil
+}
+
+// Champion resolves ChampionID
+func (m *ChampionMasteryDTO) Champion(data *StaticData) *Champion {
+	return data.Champion(int64(m.ChampionID))
+}
+
+// Champion resolves ChampionID
+func (b *BannedChampion) Champion(data *StaticData) *Champion {
+	return data.Champion(b.ChampionID)
+}
+
+// Champion resolves ChampionID
+func (b *TeamBansDTO) Champion(data *StaticData) *Champion {
+	return data.Champion(int64(b.ChampionID))
+}
+
+// Champion resolves ChampionID
+func (p *CurrentGameParticipant) Champion(data *StaticData) *Champion {
+	return data.Champion(p.ChampionID)
+}
+
+// SummonerSpells resolves Spell1ID and Spell2ID
+func (p *CurrentGameParticipant) SummonerSpells(data *StaticData) (*SummonerSpell, *SummonerSpell) {
+	return data.SummonerSpell(p.Spell1ID), data.SummonerSpell(p.Spell2ID)
+}
+
+// Champion resolves ChampionID
+func (p *ParticipantDTO) Champion(data *StaticData) *Champion {
+	return data.Champion(int64(p.ChampionID))
+}
+
+// SummonerSpells resolves Spell1ID and Spell2ID
+func (p *ParticipantDTO) SummonerSpells(data *StaticData) (*SummonerSpell, *SummonerSpell) {
+	return data.SummonerSpell(int64(p.Spell1ID)), data.SummonerSpell(int64(p.Spell2ID))
+}
+
+// Items resolves the participant's item slots, see ParticipantStatsDTO.Items
+func (p *ParticipantDTO) Items(data *StaticData) []*Item {
+	return p.Stats.Items(data)
+}
+
+// Items resolves Item0 to Item6 in slot order, empty slots are nil
+func (s *ParticipantStatsDTO) Items(data *StaticData) []*Item {
+	slots := []int{s.Item0, s.Item1, s.Item2, s.Item3, s.Item4, s.Item5, s.Item6}
+	items := make([]*Item, len(slots))
+	for i, id := range slots {
+		items[i] = data.Item(int64(id))
+	}
+	return items
+}
+
+// ItemNames returns the names of the filled item slots in slot order
+func (s *ParticipantStatsDTO) ItemNames(data *StaticData) []string {
+	var names []string
+	for _, item := range s.Items(data) {
+		if item != nil {
+			names = append(names, item.Name)
+		}
+	}
+	return names
+}
+
+// Keystone resolves Perk0
+func (s *ParticipantStatsDTO) Keystone(data *StaticData) *Rune {
+	return data.Keystone(int64(s.Perk0))
+}
+
+// Runes resolves Perk0 to Perk5
+func (s *ParticipantStatsDTO) Runes(data *StaticData) []*Rune {
+	perks := []int{s.Perk0, s.Perk1, s.Perk2, s.Perk3, s.Perk4, s.Perk5}
+	runes := make([]*Rune, len(perks))
+	for i, id := range perks {
+		runes[i] = data.Rune(int64(id))
+	}
+	return runes
+}
+
+// PrimaryStyle resolves PerkPrimaryStyle
+func (s *ParticipantStatsDTO) PrimaryStyle(data *StaticData) *RuneTree {
+	return data.RuneTree(int64(s.PerkPrimaryStyle))
+}
+
+// SubStyle resolves PerkSubStyle
+func (s *ParticipantStatsDTO) SubStyle(data *StaticData) *RuneTree {
+	return data.RuneTree(int64(s.PerkSubStyle))
+}
+
+// Keystone resolves the first of PerkIDs
+func (p *Perks) Keystone(data *StaticData) *Rune {
+	if len(p.PerkIDs) == 0 {
+		return nil
+	}
+	return data.Keystone(p.PerkIDs[0])
+}
+
+// Runes resolves PerkIDs, stat shards resolve to nil
+func (p *Perks) Runes(data *StaticData) []*Rune {
+	runes := make([]*Rune, len(p.PerkIDs))
+	for i, id := range p.PerkIDs {
+		runes[i] = data.Rune(id)
+	}
+	return runes
+}
+
+// PrimaryStyle resolves PerkStyle
+func (p *Perks) PrimaryStyle(data *StaticData) *RuneTree {
+	return data.RuneTree(p.PerkStyle)
+}
+
+// SubStyle resolves PerkSubStyle
+func (p *Perks) SubStyle(data *StaticData) *RuneTree {
+	return data.RuneTree(p.PerkSubStyle)
+}
@@ -0,0 +1,173 @@
+package lol
+
+import (
+	"log"
+	"net/http"
+	"testing"
+
+	"github.com/dnaeon/go-vcr/recorder"
+)
+
+func TestChampionMasteryChampion(t *testing.T) {
+	rec, err := recorder.New("cassettes/lol/champion-mastery-v4/champion-mastery")
+	if err != nil {
+		log.Fatal(err)
+	} else {
+		rec.SetMatcher(matchWithoutToken)
+		httpClient = &http.Client{Transport: rec}
+	}
+	rec.AddFilter(removeToken)
+	defer rec.Stop()
+	cli, err := NewClient(testToken, WithHTTPClient(httpClient))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	dto, resp, err := cli.ChampionMastery(encryptedSummonerID, "39")
+	if resp.StatusCode != 200 {
+		t.Errorf("\nExpected: 200 status code\nActual: %d status code", resp.StatusCode)
+		return
+	}
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	data, err := LoadStaticData(newBundleDataDragon(t))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if expected, actual := "Irelia", dto.Champion(data).Name; expected != actual {
+		t.Errorf("\nExpected: %s\nActual: %s\n", expected, actual)
+		return
+	}
+}
+
+func TestParticipantResolvers(t *testing.T) {
+	rec, err := recorder.New("cassettes/lol/match-v4/matches")
+	if err != nil {
+		log.Fatal(err)
+	} else {
+		rec.SetMatcher(matchWithoutToken)
+		httpClient = &http.Client{Transport: rec}
+	}
+	rec.AddFilter(removeToken)
+	defer rec.Stop()
+	cli, err := NewClient(testToken, WithHTTPClient(httpClient))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	dto, resp, err := cli.Matches(matchID)
+	if resp.StatusCode != 200 {
+		t.Errorf("\nExpected: 200 status code\nActual: %d status code", resp.StatusCode)
+		return
+	}
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	data, err := LoadStaticData(newBundleDataDragon(t))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	participant := dto.Participants[0]
+	spell1, spell2 := participant.SummonerSpells(data)
+	if expected, actual := "Bard", participant.Champion(data).Name; expected != actual {
+		t.Errorf("\nExpected: %s\nActual: %s\n", expected, actual)
+		return
+	}
+	if expected, actual := "Flash", spell1.Name; expected != actual {
+		t.Errorf("\nExpected: %s\nActual: %s\n", expected, actual)
+		return
+	}
+	if expected, actual := "Ignite", spell2.Name; expected != actual {
+		t.Errorf("\nExpected: %s\nActual: %s\n", expected, actual)
+		return
+	}
+	if expected, actual := "Zhonya's Hourglass", participant.Items(data)[1].Name; expected != actual {
+		t.Errorf("\nExpected: %s\nActual: %s\n", expected, actual)
+		return
+	}
+	if expected, actual := "Warding Totem (Trinket)", participant.Stats.ItemNames(data)[6]; expected != actual {
+		t.Errorf("\nExpected: %s\nActual: %s\n", expected, actual)
+		return
+	}
+	if expected, actual := "Electrocute", participant.Stats.Keystone(data).Name; expected != actual {
+		t.Errorf("\nExpected: %s\nActual: %s\n", expected, actual)
+		return
+	}
+	if expected, actual := "Domination", participant.Stats.PrimaryStyle(data).Name; expected != actual {
+		t.Errorf("\nExpected: %s\nActual: %s\n", expected, actual)
+		return
+	}
+	if expected, actual := "Inspiration", participant.Stats.SubStyle(data).Name; expected != actual {
+		t.Errorf("\nExpected: %s\nActual: %s\n", expected, actual)
+		return
+	}
+	if expected, actual := "Kai'Sa", dto.Teams[0].Bans[0].Champion(data).Name; expected != actual {
+		t.Errorf("\nExpected: %s\nActual: %s\n", expected, actual)
+		return
+	}
+}
+
+func TestCurrentGameParticipantResolvers(t *testing.T) {
+	rec, err := recorder.New("cassettes/lol/spectator-v4/active-games")
+	if err != nil {
+		log.Fatal(err)
+	} else {
+		rec.SetMatcher(matchWithoutToken)
+		httpClient = &http.Client{Transport: rec}
+	}
+	rec.AddFilter(removeToken)
+	defer rec.Stop()
+	cli, err := NewClient(testToken, WithHTTPClient(httpClient))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	dto, resp, err := cli.ActiveGames(encryptedSummonerID)
+	if resp.StatusCode != 200 {
+		t.Errorf("\nExpected: 200 status code\nActual: %d status code", resp.StatusCode)
+		return
+	}
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	data, err := LoadStaticData(newBundleDataDragon(t))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	participant := dto.Participants[0]
+	spell1, _ := participant.SummonerSpells(data)
+	if expected, actual := "Diana", participant.Champion(data).Name; expected != actual {
+		t.Errorf("\nExpected: %s\nActual: %s\n", expected, actual)
+		return
+	}
+	if expected, actual := "Flash", spell1.Name; expected != actual {
+		t.Errorf("\nExpected: %s\nActual: %s\n", expected, actual)
+		return
+	}
+	if expected, actual := "Electrocute", participant.Perks.Keystone(data).Name; expected != actual {
+		t.Errorf("\nExpected: %s\nActual: %s\n", expected, actual)
+		return
+	}
+	if expected, actual := "Sorcery", participant.Perks.SubStyle(data).Name; expected != actual {
+		t.Errorf("\nExpected: %s\nActual: %s\n", expected, actual)
+		return
+	}
+	if expected, actual := "Nocturne", dto.BannedChampions[0].Champion(data).Name; expected != actual {
+		t.Errorf("\nExpected: %s\nActual: %s\n", expected, actual)
+		return
+	}
+	if runes := participant.Perks.Runes(data); runes[1] == nil || runes[6] != nil {
+		t.Errorf("\nExpected: runes resolved and stat shards nil\nActual: %v\n", runes)
+		return
+	}
+}
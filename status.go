@@ -0,0 +1,125 @@
+package lol
+
+import (
+	"net/http"
+	"strings"
+)
+
+// DefaultLocale is the locale status messages fall back to and the locale Data Dragon static data
+// is loaded in unless WithDataDragonLocale is used
+const DefaultLocale = "en_US"
+
+type PlatformDataDTO struct {
+	ID           string      `json:"id"`
+	Name         string      `json:"name"`
+	Locales      []string    `json:"locales"`
+	Maintenances []StatusDTO `json:"maintenances"`
+	Incidents    []StatusDTO `json:"incidents"`
+}
+
+type StatusDTO struct {
+	ID                int          `json:"id"`
+	MaintenanceStatus string       `json:"maintenance_status"`
+	IncidentSeverity  string       `json:"incident_severity"`
+	Titles            []ContentDTO `json:"titles"`
+	Updates           []UpdateDTO  `json:"updates"`
+	CreatedAt         string       `json:"created_at"`
+	ArchiveAt         string       `json:"archive_at"`
+	UpdatedAt         string       `json:"updated_at"`
+	Platforms         []string     `json:"platforms"`
+}
+
+type ContentDTO struct {
+	Locale  string `json:"locale"`
+	Content string `json:"content"`
+}
+
+type UpdateDTO struct {
+	ID               int          `json:"id"`
+	Author           string       `json:"author"`
+	Publish          bool         `json:"publish"`
+	PublishLocations []string     `json:"publish_locations"`
+	Translations     []ContentDTO `json:"translations"`
+	CreatedAt        string       `json:"created_at"`
+	UpdatedAt        string       `json:"updated_at"`
+}
+
+// PlatformData GET /lol/status/v4/platform-data
+func (l *LOL) PlatformData() (*PlatformDataDTO, *http.Response, error) {
+	dto := new(PlatformDataDTO)
+	var reqErr error
+	resp, err := l.sling.New().Get("status/v4/platform-data").Receive(dto, reqErr)
+	if err != nil {
+		return nil, resp, err
+	}
+	return dto, resp, reqErr
+}
+
+// Localized returns the translation of the message that best matches locale, see BestLocale.
+// The untranslated heading and content are returned when no translation fits.
+func (m *Message) Localized(locale string) Translation {
+	locales := make([]string, len(m.Translations))
+	for i, t := range m.Translations {
+		locales[i] = t.Locale
+	}
+	if i := BestLocale(locales, locale); i >= 0 && i < len(m.Translations) {
+		return m.Translations[i]
+	}
+	return Translation{Locale: DefaultLocale, Heading: m.Heading, Content: m.Content, UpdatedAt: m.UpdatedAt}
+}
+
+// LocalizedTitle returns the title that best matches locale, see BestLocale
+func (s *StatusDTO) LocalizedTitle(locale string) string {
+	return localizedContent(s.Titles, locale)
+}
+
+// Localized returns the translation that best matches locale, see BestLocale
+func (u *UpdateDTO) Localized(locale string) string {
+	return localizedContent(u.Translations, locale)
+}
+
+func localizedContent(contents []ContentDTO, locale string) string {
+	locales := make([]string, len(contents))
+	for i, c := range contents {
+		locales[i] = c.Locale
+	}
+	if i := BestLocale(locales, locale); i >= 0 {
+		return contents[i].Content
+	}
+	return ""
+}
+
+// BestLocale returns the index in locales that best matches locale: the same locale, then the
+// same language, then en_US. It returns -1 when none of those are present. Locales compare
+// case insensitively and with either - or _ as separator.
+func BestLocale(locales []string, locale string) int {
+	want := normalizeLocale(locale)
+	language := localeLanguage(want)
+	sameLanguage, fallback := -1, -1
+	for i, l := range locales {
+		l = normalizeLocale(l)
+		switch {
+		case l == want:
+			return i
+		case sameLanguage < 0 && localeLanguage(l) == language:
+			sameLanguage = i
+		case fallback < 0 && l == normalizeLocale(DefaultLocale):
+			fallback = i
+		}
+	}
+	if sameLanguage >= 0 {
+		return sameLanguage
+	}
+	return fallback
+}
+
+func normalizeLocale(locale string) string {
+	return strings.ToLower(strings.Replace(locale, "-", "_", -1))
+}
+
+func localeLanguage(locale string) string {
+	if i := strings.Index(locale, "_"); i >= 0 {
+		return locale[:i]
+	}
+	return locale
+}
@@ -0,0 +1,79 @@
+package lol
+
+import (
+	"log"
+	"net/http"
+	"testing"
+
+	"github.com/dnaeon/go-vcr/recorder"
+)
+
+func TestPlatformData(t *testing.T) {
+	rec, err := recorder.New("cassettes/lol/lol-status-v4/platform-data")
+	if err != nil {
+		log.Fatal(err)
+	} else {
+		rec.SetMatcher(matchWithoutToken)
+		httpClient = &http.Client{Transport: rec}
+	}
+	rec.AddFilter(removeToken)
+	defer rec.Stop()
+	cli, err := NewClient(testToken, WithHTTPClient(httpClient))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	dto, resp, err := cli.PlatformData()
+	if resp.StatusCode != 200 {
+		t.Errorf("\nExpected: 200 status code\nActual: %d status code", resp.StatusCode)
+		return
+	}
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	expected := "Filas ranqueadas desativadas"
+	actual := dto.Incidents[0].LocalizedTitle("pt-BR")
+	if expected != actual {
+		t.Errorf("\nExpected: %s\nActual: %s\n", expected, actual)
+		return
+	}
+}
+
+func TestBestLocale(t *testing.T) {
+	locales := []string{"en_US", "es_MX", "pt_BR"}
+	tests := []struct {
+		locale   string
+		expected int
+	}{
+		{"pt_BR", 2},
+		{"es-mx", 1},
+		{"es_ES", 1},
+		{"ko_KR", 0},
+	}
+	for _, test := range tests {
+		expected := test.expected
+		actual := BestLocale(locales, test.locale)
+		if expected != actual {
+			t.Errorf("\nExpected: %d\nActual: %d\n", expected, actual)
+			return
+		}
+	}
+	expected := -1
+	actual := BestLocale([]string{"ko_KR"}, "ja_JP")
+	if expected != actual {
+		t.Errorf("\nExpected: %d\nActual: %d\n", expected, actual)
+		return
+	}
+}
+
+func TestMessageLocalized(t *testing.T) {
+	message := Message{Heading: "Store", Content: "Transfers are disabled"}
+	expected := "Transfers are disabled"
+	actual := message.Localized("de_DE").Content
+	if expected != actual {
+		t.Errorf("\nExpected: %s\nActual: %s\n", expected, actual)
+		return
+	}
+}
@@ -9,30 +9,80 @@ import (
 
 // TFT provides methods to interface with tft resource
 type TFT struct {
-	sling *sling.Sling
+	sling    *sling.Sling
+	regional *sling.Sling
+	// spectator is served under /lol/ on the platform host
+	spectator *sling.Sling
 }
 
-// NewTFT returns a new TFT
+// TFT queues for EntriesParams.Queue and RatedLadder
+const (
+	TFTQueueRanked   = "RANKED_TFT"
+	TFTQueueDoubleUp = "RANKED_TFT_DOUBLE_UP"
+	TFTQueueTurbo    = "RANKED_TFT_TURBO"
+)
+
+// Rated tiers of TFT rated queues from lowest to highest, named after the color shown in client
+const (
+	RatedTierGray   = "GRAY"
+	RatedTierGreen  = "GREEN"
+	RatedTierBlue   = "BLUE"
+	RatedTierPurple = "PURPLE"
+	RatedTierOrange = "ORANGE"
+)
+
+type TopRatedLadderEntryDTO struct {
+	Puuid                        string `json:"puuid"`
+	SummonerID                   string `json:"summonerId"`
+	SummonerName                 string `json:"summonerName"`
+	RatedTier                    string `json:"ratedTier"`
+	RatedRating                  int    `json:"ratedRating"`
+	Wins                         int    `json:"wins"`
+	PreviousUpdateLadderPosition int    `json:"previousUpdateLadderPosition"`
+}
+
+// NewTFT returns a new TFT, endpoints served by regional routing values go to the regional host
+// of the platform sling is based on. Use NewTFTWithRegion to pick the regional host.
 func NewTFT(sling *sling.Sling) *TFT {
-	return &TFT{sling: sling.New().Path("tft/")}
+	return NewTFTWithRegion(sling, routedSling(sling, regionalRoute))
+}
+
+// NewTFTWithRegion returns a new TFT, regional is used for endpoints served by regional routing values
+func NewTFTWithRegion(sling, regional *sling.Sling) *TFT {
+	return &TFT{
+		sling:     sling.New().Path("tft/"),
+		regional:  regional.New().Path("tft/"),
+		spectator: sling.New().Path("lol/spectator/tft/"),
+	}
 }
 
 // Challenger GET /tft/league/v1/challenger
 func (t *TFT) Challenger() (*LeagueListDTO, *http.Response, error) {
 	dto := new(LeagueListDTO)
 	var reqErr error
-	resp, err := t.sling.Get("league/v1/challenger").Receive(dto, reqErr)
+	resp, err := t.sling.New().Get("league/v1/challenger").Receive(dto, reqErr)
 	if err != nil {
 		return nil, resp, err
 	}
 	return dto, resp, reqErr
 }
 
+// EntriesByPUUID GET /tft/league/v1/by-puuid/{encryptedPUUID}
+func (t *TFT) EntriesByPUUID(encryptedPUUID string) ([]LeagueEntryDTO, *http.Response, error) {
+	dtos := new([]LeagueEntryDTO)
+	var reqErr error
+	resp, err := t.sling.New().Get("league/v1/by-puuid/"+encryptedPUUID).Receive(dtos, reqErr)
+	if err != nil {
+		return nil, resp, err
+	}
+	return *dtos, resp, reqErr
+}
+
 // EntriesBySummoner GET /tft/league/v1/entries/by-summoner/{encryptedSummonerID}
 func (t *TFT) EntriesBySummoner(encryptedSummonerID string) ([]LeagueEntryDTO, *http.Response, error) {
 	dtos := new([]LeagueEntryDTO)
 	var reqErr error
-	resp, err := t.sling.Get("league/v1/entries/by-summoner/"+encryptedSummonerID).Receive(dtos, reqErr)
+	resp, err := t.sling.New().Get("league/v1/entries/by-summoner/"+encryptedSummonerID).Receive(dtos, reqErr)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -44,7 +94,7 @@ func (t *TFT) Entries(tier, division string, params *EntriesParams) ([]LeagueEnt
 	dtos := new([]LeagueEntryDTO)
 	var reqErr error
 	endpoint := fmt.Sprintf("league/v1/entries/%s/%s", tier, division)
-	resp, err := t.sling.Get(endpoint).QueryStruct(params).Receive(dtos, reqErr)
+	resp, err := t.sling.New().Get(endpoint).QueryStruct(params).Receive(dtos, reqErr)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -55,7 +105,7 @@ func (t *TFT) Entries(tier, division string, params *EntriesParams) ([]LeagueEnt
 func (t *TFT) Grandmaster() (*LeagueListDTO, *http.Response, error) {
 	dto := new(LeagueListDTO)
 	var reqErr error
-	resp, err := t.sling.Get("league/v1/grandmaster").Receive(dto, reqErr)
+	resp, err := t.sling.New().Get("league/v1/grandmaster").Receive(dto, reqErr)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -66,7 +116,7 @@ func (t *TFT) Grandmaster() (*LeagueListDTO, *http.Response, error) {
 func (t *TFT) Leagues(leagueID string) (*LeagueListDTO, *http.Response, error) {
 	dto := new(LeagueListDTO)
 	var reqErr error
-	resp, err := t.sling.Get("league/v1/leagues/"+leagueID).Receive(dto, reqErr)
+	resp, err := t.sling.New().Get("league/v1/leagues/"+leagueID).Receive(dto, reqErr)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -77,20 +127,29 @@ func (t *TFT) Leagues(leagueID string) (*LeagueListDTO, *http.Response, error) {
 func (t *TFT) Master() (*LeagueListDTO, *http.Response, error) {
 	dto := new(LeagueListDTO)
 	var reqErr error
-	resp, err := t.sling.Get("league/v1/master").Receive(dto, reqErr)
+	resp, err := t.sling.New().Get("league/v1/master").Receive(dto, reqErr)
 	if err != nil {
 		return nil, resp, err
 	}
 	return dto, resp, reqErr
 }
 
+// RatedLadder GET /tft/league/v1/rated-ladders/{queue}/top
+func (t *TFT) RatedLadder(queue string) ([]TopRatedLadderEntryDTO, *http.Response, error) {
+	dtos := new([]TopRatedLadderEntryDTO)
+	var reqErr error
+	resp, err := t.sling.New().Get("league/v1/rated-ladders/"+queue+"/top").Receive(dtos, reqErr)
+	if err != nil {
+		return nil, resp, err
+	}
+	return *dtos, resp, reqErr
+}
+
 // MatchesByPUUID GET /tft/match/v1/matches/by-puuid/{encryptedPUUID}/ids
 func (t *TFT) MatchesByPUUID(encryptedPUUID string) ([]string, *http.Response, error) {
 	data := new([]string)
 	var reqErr error
-	// TODO: need to lookup the region and map it to "americas", "asia", or "EUROPE"
-	// TODO: add region/token to the service struct
-	resp, err := t.sling.Get("https://americas.api.riotgames.com/tft/match/v1/matches/by-puuid/"+encryptedPUUID+"/ids").Receive(data, reqErr)
+	resp, err := t.regional.New().Get("match/v1/matches/by-puuid/"+encryptedPUUID+"/ids").Receive(data, reqErr)
 	if err != nil {
 		return nil, resp, err
 	}
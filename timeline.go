@@ -0,0 +1,161 @@
+package lol
+
+import (
+	"time"
+)
+
+// SnapshotMinutes are the game minutes DiffSeries.Snapshots reports when none are given
+var SnapshotMinutes = []int{10, 15, 20}
+
+// TimelineDiff is the gold, XP and CS difference at one frame of a timeline, positive when
+// the side asked about is ahead. CS counts lane and jungle minions.
+type TimelineDiff struct {
+	// Timestamp is milliseconds since the game started
+	Timestamp int
+	Gold      int
+	XP        int
+	CS        int
+}
+
+// DiffSeries is a TimelineDiff per timeline frame, oldest first
+type DiffSeries []TimelineDiff
+
+// Time returns Timestamp as a duration, e.g. for plot axes
+func (d TimelineDiff) Time() time.Duration {
+	return time.Duration(d.Timestamp) * time.Millisecond
+}
+
+// TeamDiff returns how far teamID was ahead of the other team at every frame. In match-v4
+// participants 1 to 5 play for team 100 and 6 to 10 for team 200.
+func (t *MatchTimelineDTO) TeamDiff(teamID int) DiffSeries {
+	series := make(DiffSeries, 0, len(t.Frames))
+	for _, f := range t.Frames {
+		d := TimelineDiff{Timestamp: f.Timestamp}
+		// participantFrames keys do not always match participantId, so use the frame's own ID
+		for _, pf := range f.ParticipantFrames {
+			sign := 1
+			if participantTeam(pf.ParticipantID) != teamID {
+				sign = -1
+			}
+			d.Gold += sign * pf.TotalGold
+			d.XP += sign * pf.XP
+			d.CS += sign * (pf.MinionsKilled + pf.JungleMinionsKilled)
+		}
+		series = append(series, d)
+	}
+	return series
+}
+
+// ParticipantDiff returns how far participantID was ahead of opponentID at every frame.
+// Frames missing either participant are skipped.
+func (t *MatchTimelineDTO) ParticipantDiff(participantID, opponentID int) DiffSeries {
+	series := make(DiffSeries, 0, len(t.Frames))
+	for _, f := range t.Frames {
+		p, ok := f.participantFrame(participantID)
+		if !ok {
+			continue
+		}
+		o, ok := f.participantFrame(opponentID)
+		if !ok {
+			continue
+		}
+		series = append(series, TimelineDiff{
+			Timestamp: f.Timestamp,
+			Gold:      p.TotalGold - o.TotalGold,
+			XP:        p.XP - o.XP,
+			CS:        p.MinionsKilled + p.JungleMinionsKilled - o.MinionsKilled - o.JungleMinionsKilled,
+		})
+	}
+	return series
+}
+
+// LaneDiff returns how far participantID was ahead of its lane opponent in match, see
+// MatchDTO.LaneOpponent. It returns false when no opponent is found.
+func (t *MatchTimelineDTO) LaneDiff(match *MatchDTO, participantID int) (DiffSeries, bool) {
+	opponentID, ok := match.LaneOpponent(participantID)
+	if !ok {
+		return nil, false
+	}
+	return t.ParticipantDiff(participantID, opponentID), true
+}
+
+// LaneOpponent returns the participant of the other team that played the same lane and role
+// as participantID. Lane assignment is inferred by Riot and can be off, e.g. two junglers on one
+// team, so it returns false unless exactly one participant matches.
+func (m *MatchDTO) LaneOpponent(participantID int) (int, bool) {
+	var self *ParticipantDTO
+	for i := range m.Participants {
+		if m.Participants[i].ParticipantID == participantID {
+			self = &m.Participants[i]
+		}
+	}
+	if self == nil {
+		return 0, false
+	}
+	opponentID, found := 0, 0
+	for _, p := range m.Participants {
+		if p.TeamID == self.TeamID || p.Timeline.Lane != self.Timeline.Lane || p.Timeline.Role != self.Timeline.Role {
+			continue
+		}
+		opponentID = p.ParticipantID
+		found++
+	}
+	return opponentID, found == 1
+}
+
+// At returns the first frame at or after minute, false when the game ended before it
+func (s DiffSeries) At(minute int) (TimelineDiff, bool) {
+	ts := minute * int(time.Minute/time.Millisecond)
+	for _, d := range s {
+		if d.Timestamp >= ts {
+			return d, true
+		}
+	}
+	return TimelineDiff{}, false
+}
+
+// Snapshots returns the frames at minutes, SnapshotMinutes by default, keyed by minute.
+// Minutes the game did not reach are left out.
+func (s DiffSeries) Snapshots(minutes ...int) map[int]TimelineDiff {
+	if len(minutes) == 0 {
+		minutes = SnapshotMinutes
+	}
+	snapshots := make(map[int]TimelineDiff, len(minutes))
+	for _, minute := range minutes {
+		if d, ok := s.At(minute); ok {
+			snapshots[minute] = d
+		}
+	}
+	return snapshots
+}
+
+// LargestGoldLead returns the frame with the biggest gold lead, false when never ahead
+func (s DiffSeries) LargestGoldLead() (TimelineDiff, bool) {
+	var lead TimelineDiff
+	for _, d := range s {
+		if d.Gold > lead.Gold {
+			lead = d
+		}
+	}
+	return lead, lead.Gold > 0
+}
+
+// LargestGoldDeficit returns the frame with the biggest gold deficit, false when never behind
+func (s DiffSeries) LargestGoldDeficit() (TimelineDiff, bool) {
+	var deficit TimelineDiff
+	for _, d := range s {
+		if d.Gold < deficit.Gold {
+			deficit = d
+		}
+	}
+	return deficit, deficit.Gold < 0
+}
+
+func (f *MatchFrameDTO) participantFrame(participantID int) (MatchParicipantDTO, bool) {
+	for _, pf := range f.ParticipantFrames {
+		if pf.ParticipantID == participantID {
+			return pf, true
+		}
+	}
+	return MatchParicipantDTO{}, false
+}
@@ -0,0 +1,113 @@
+package lol
+
+import (
+	"log"
+	"net/http"
+	"testing"
+
+	"github.com/dnaeon/go-vcr/recorder"
+)
+
+func TestTimelineTeamDiff(t *testing.T) {
+	rec, err := recorder.New("cassettes/lol/match-v4/timelines")
+	if err != nil {
+		log.Fatal(err)
+	} else {
+		rec.SetMatcher(matchWithoutToken)
+		httpClient = &http.Client{Transport: rec}
+	}
+	rec.AddFilter(removeToken)
+	defer rec.Stop()
+	cli, err := NewClient(testToken, WithHTTPClient(httpClient))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	tl, resp, err := cli.Timelines(matchID)
+	if resp.StatusCode != 200 {
+		t.Errorf("\nExpected: 200 status code\nActual: %d status code", resp.StatusCode)
+		return
+	}
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	series := tl.TeamDiff(100)
+	snapshots := series.Snapshots()
+	expected := map[int]TimelineDiff{
+		10: {Timestamp: 600209, Gold: 2107, XP: 2096, CS: -7},
+		15: {Timestamp: 900324, Gold: 3822, XP: 3881, CS: 11},
+		20: {Timestamp: 1200396, Gold: 3282, XP: 1678, CS: 11},
+	}
+	for minute, e := range expected {
+		if a := snapshots[minute]; e != a {
+			t.Errorf("\nExpected: %+v\nActual: %+v\n", e, a)
+			return
+		}
+	}
+	if _, ok := series.At(45); ok {
+		t.Error("\nExpected: no frame at 45 minutes\nActual: found one")
+		return
+	}
+	lead, ok := series.LargestGoldLead()
+	if e := (TimelineDiff{Timestamp: 1560483, Gold: 7150, XP: 7897, CS: 17}); !ok || e != lead {
+		t.Errorf("\nExpected: %+v\nActual: %+v\n", e, lead)
+		return
+	}
+	deficit, ok := series.LargestGoldDeficit()
+	if e := (TimelineDiff{Timestamp: 120041, Gold: -31, XP: 70, CS: -1}); !ok || e != deficit {
+		t.Errorf("\nExpected: %+v\nActual: %+v\n", e, deficit)
+		return
+	}
+	mirrored, _ := tl.TeamDiff(200).LargestGoldDeficit()
+	if expected, actual := -lead.Gold, mirrored.Gold; expected != actual {
+		t.Errorf("\nExpected: %d\nActual: %d\n", expected, actual)
+		return
+	}
+
+	top, ok := tl.ParticipantDiff(3, 10).At(10)
+	if e := (TimelineDiff{Timestamp: 600209, Gold: -2013, XP: -1956, CS: -61}); !ok || e != top {
+		t.Errorf("\nExpected: %+v\nActual: %+v\n", e, top)
+	}
+}
+
+func TestLaneOpponent(t *testing.T) {
+	rec, err := recorder.New("cassettes/lol/match-v4/matches")
+	if err != nil {
+		log.Fatal(err)
+	} else {
+		rec.SetMatcher(matchWithoutToken)
+		httpClient = &http.Client{Transport: rec}
+	}
+	rec.AddFilter(removeToken)
+	defer rec.Stop()
+	cli, err := NewClient(testToken, WithHTTPClient(httpClient))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	dto, resp, err := cli.Matches(matchID)
+	if resp.StatusCode != 200 {
+		t.Errorf("\nExpected: 200 status code\nActual: %d status code", resp.StatusCode)
+		return
+	}
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	opponent, ok := dto.LaneOpponent(3)
+	if expected := 10; !ok || expected != opponent {
+		t.Errorf("\nExpected: %d\nActual: %d\n", expected, opponent)
+		return
+	}
+	// team 200 has two junglers and no mid laner
+	if _, ok := dto.LaneOpponent(2); ok {
+		t.Error("\nExpected: no opponent for the jungler\nActual: found one")
+		return
+	}
+	if _, ok := dto.LaneOpponent(4); ok {
+		t.Error("\nExpected: no opponent for the mid laner\nActual: found one")
+	}
+}
@@ -0,0 +1,173 @@
+package lol
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/dghubble/sling"
+)
+
+// Tournament provides methods to interface with tournament resource.
+// A Tournament made by NewTournamentStub calls the matching tournament-stub routes instead,
+// methods the stub has no route for return ErrNotOnStub.
+type Tournament struct {
+	sling *sling.Sling
+	stub  bool
+}
+
+var (
+	// ErrNotOnStub returned by Tournament methods tournament-stub-v4 has no route for
+	ErrNotOnStub = errors.New("lol: not available on tournament-stub-v4")
+)
+
+// Pick types for TournamentCodeParameters
+const (
+	PickTypeBlindPick       = "BLIND_PICK"
+	PickTypeDraftMode       = "DRAFT_MODE"
+	PickTypeAllRandom       = "ALL_RANDOM"
+	PickTypeTournamentDraft = "TOURNAMENT_DRAFT"
+)
+
+// Map types for TournamentCodeParameters
+const (
+	MapTypeSummonersRift   = "SUMMONERS_RIFT"
+	MapTypeTwistedTreeline = "TWISTED_TREELINE"
+	MapTypeHowlingAbyss    = "HOWLING_ABYSS"
+)
+
+// Spectator types for TournamentCodeParameters
+const (
+	SpectatorTypeNone      = "NONE"
+	SpectatorTypeLobbyOnly = "LOBBYONLY"
+	SpectatorTypeAll       = "ALL"
+)
+
+type CodesParams struct {
+	Count        int `url:"count,omitempty"`
+	TournamentID int `url:"tournamentId"`
+}
+
+type TournamentCodeParameters struct {
+	AllowedSummonerIDs []string `json:"allowedSummonerIds,omitempty"`
+	Metadata           string   `json:"metadata,omitempty"`
+	TeamSize           int      `json:"teamSize"`
+	PickType           string   `json:"pickType,omitempty"`
+	MapType            string   `json:"mapType,omitempty"`
+	SpectatorType      string   `json:"spectatorType,omitempty"`
+}
+
+type TournamentCodeDTO struct {
+	Code         string   `json:"code"`
+	Spectators   string   `json:"spectators"`
+	LobbyName    string   `json:"lobbyName"`
+	MetaData     string   `json:"metaData"`
+	Password     string   `json:"password"`
+	TeamSize     int      `json:"teamSize"`
+	ProviderID   int      `json:"providerId"`
+	PickType     string   `json:"pickType"`
+	TournamentID int      `json:"tournamentId"`
+	ID           int      `json:"id"`
+	Region       string   `json:"region"`
+	Map          string   `json:"map"`
+	Participants []string `json:"participants"`
+}
+
+type TournamentCodeUpdateParameters struct {
+	AllowedSummonerIDs []string `json:"allowedSummonerIds,omitempty"`
+	PickType           string   `json:"pickType,omitempty"`
+	MapType            string   `json:"mapType,omitempty"`
+	SpectatorType      string   `json:"spectatorType,omitempty"`
+}
+
+type LobbyEventDTOWrapper struct {
+	EventList []LobbyEventDTO `json:"eventList"`
+}
+
+type LobbyEventDTO struct {
+	SummonerID string `json:"summonerId"`
+	EventType  string `json:"eventType"`
+	Timestamp  string `json:"timestamp"`
+}
+
+type ProviderRegistrationParameters struct {
+	Region string `json:"region"`
+	URL    string `json:"url"`
+}
+
+type TournamentRegistrationParameters struct {
+	ProviderID int    `json:"providerId"`
+	Name       string `json:"name,omitempty"`
+}
+
+// NewTournament returns a new Tournament
+func NewTournament(sling *sling.Sling) *Tournament {
+	return &Tournament{sling: sling.New().Path("lol/tournament/v4/")}
+}
+
+// NewTournamentStub returns a new Tournament backed by tournament-stub-v4
+func NewTournamentStub(sling *sling.Sling) *Tournament {
+	return &Tournament{sling: sling.New().Path("lol/tournament-stub/v4/"), stub: true}
+}
+
+// Codes POST /lol/tournament/v4/codes
+func (t *Tournament) Codes(params *CodesParams, body *TournamentCodeParameters) ([]string, *http.Response, error) {
+	codes := new([]string)
+	resp, err := post(t.sling.New().QueryStruct(params), "codes", body, codes)
+	if err != nil {
+		return nil, resp, err
+	}
+	return *codes, resp, nil
+}
+
+// Code GET /lol/tournament/v4/codes/{tournamentCode}, ErrNotOnStub on the stub
+func (t *Tournament) Code(tournamentCode string) (*TournamentCodeDTO, *http.Response, error) {
+	if t.stub {
+		return nil, nil, ErrNotOnStub
+	}
+	dto := new(TournamentCodeDTO)
+	var reqErr error
+	resp, err := t.sling.New().Get("codes/"+tournamentCode).Receive(dto, reqErr)
+	if err != nil {
+		return nil, resp, err
+	}
+	return dto, resp, reqErr
+}
+
+// UpdateCode PUT /lol/tournament/v4/codes/{tournamentCode}, ErrNotOnStub on the stub
+func (t *Tournament) UpdateCode(tournamentCode string, body *TournamentCodeUpdateParameters) (*http.Response, error) {
+	if t.stub {
+		return nil, ErrNotOnStub
+	}
+	return put(t.sling, "codes/"+tournamentCode, body, nil)
+}
+
+// LobbyEventsByCode GET /lol/tournament/v4/lobby-events/by-code/{tournamentCode}
+func (t *Tournament) LobbyEventsByCode(tournamentCode string) (*LobbyEventDTOWrapper, *http.Response, error) {
+	dto := new(LobbyEventDTOWrapper)
+	var reqErr error
+	resp, err := t.sling.New().Get("lobby-events/by-code/"+tournamentCode).Receive(dto, reqErr)
+	if err != nil {
+		return nil, resp, err
+	}
+	return dto, resp, reqErr
+}
+
+// Providers POST /lol/tournament/v4/providers
+func (t *Tournament) Providers(body *ProviderRegistrationParameters) (int, *http.Response, error) {
+	providerID := new(int)
+	resp, err := post(t.sling, "providers", body, providerID)
+	if err != nil {
+		return 0, resp, err
+	}
+	return *providerID, resp, nil
+}
+
+// Tournaments POST /lol/tournament/v4/tournaments
+func (t *Tournament) Tournaments(body *TournamentRegistrationParameters) (int, *http.Response, error) {
+	tournamentID := new(int)
+	resp, err := post(t.sling, "tournaments", body, tournamentID)
+	if err != nil {
+		return 0, resp, err
+	}
+	return *tournamentID, resp, nil
+}
@@ -0,0 +1,289 @@
+package lol
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"testing"
+
+	"github.com/dnaeon/go-vcr/recorder"
+)
+
+var (
+	tournamentProviderID = 1167
+	tournamentID         = 2214
+	tournamentCode       = "NA04373-a7c0ee6e-6d1f-4b1e-8e3e-0cd4a5a1d8d6"
+)
+
+func TestStubCodes(t *testing.T) {
+	rec, err := recorder.New("cassettes/lol/tournament-stub-v4/codes")
+	if err != nil {
+		log.Fatal(err)
+	} else {
+		rec.SetMatcher(matchWithoutToken)
+		httpClient = &http.Client{Transport: rec}
+	}
+	rec.AddFilter(removeToken)
+	defer rec.Stop()
+	cli, err := NewClient(testToken, WithHTTPClient(httpClient), WithTournamentStub())
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	params := &CodesParams{Count: 2, TournamentID: tournamentID}
+	body := &TournamentCodeParameters{
+		Metadata:      "match-1",
+		TeamSize:      5,
+		PickType:      PickTypeTournamentDraft,
+		MapType:       MapTypeSummonersRift,
+		SpectatorType: SpectatorTypeAll,
+	}
+	codes, resp, err := cli.Codes(params, body)
+	if resp.StatusCode != 200 {
+		t.Errorf("\nExpected: 200 status code\nActual: %d status code", resp.StatusCode)
+		return
+	}
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	expected := tournamentCode
+	actual := codes[0]
+	if expected != actual {
+		t.Errorf("\nExpected: %s\nActual: %s\n", expected, actual)
+		return
+	}
+}
+
+func TestStubLobbyEventsByCode(t *testing.T) {
+	rec, err := recorder.New("cassettes/lol/tournament-stub-v4/lobby-events-by-code")
+	if err != nil {
+		log.Fatal(err)
+	} else {
+		rec.SetMatcher(matchWithoutToken)
+		httpClient = &http.Client{Transport: rec}
+	}
+	rec.AddFilter(removeToken)
+	defer rec.Stop()
+	cli, err := NewClient(testToken, WithHTTPClient(httpClient), WithTournamentStub())
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	dto, resp, err := cli.LobbyEventsByCode(tournamentCode)
+	if resp.StatusCode != 200 {
+		t.Errorf("\nExpected: 200 status code\nActual: %d status code", resp.StatusCode)
+		return
+	}
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	expected := "PracticeGameCreatedEvent"
+	actual := dto.EventList[0].EventType
+	if expected != actual {
+		t.Errorf("\nExpected: %s\nActual: %s\n", expected, actual)
+		return
+	}
+}
+
+func TestStubCodeNotAvailable(t *testing.T) {
+	cli, err := NewClient(testToken, WithTournamentStub())
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if _, resp, err := cli.Code(tournamentCode); err != ErrNotOnStub || resp != nil {
+		t.Errorf("\nExpected: %v without a request\nActual: %v\n", ErrNotOnStub, err)
+		return
+	}
+	if resp, err := cli.UpdateCode(tournamentCode, &TournamentCodeUpdateParameters{MapType: MapTypeHowlingAbyss}); err != ErrNotOnStub || resp != nil {
+		t.Errorf("\nExpected: %v without a request\nActual: %v\n", ErrNotOnStub, err)
+		return
+	}
+}
+
+func TestStubProviders(t *testing.T) {
+	rec, err := recorder.New("cassettes/lol/tournament-stub-v4/providers")
+	if err != nil {
+		log.Fatal(err)
+	} else {
+		rec.SetMatcher(matchWithoutToken)
+		httpClient = &http.Client{Transport: rec}
+	}
+	rec.AddFilter(removeToken)
+	defer rec.Stop()
+	cli, err := NewClient(testToken, WithHTTPClient(httpClient), WithTournamentStub())
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	body := &ProviderRegistrationParameters{Region: "NA", URL: "https://example.com/callback"}
+	providerID, resp, err := cli.Providers(body)
+	if resp.StatusCode != 200 {
+		t.Errorf("\nExpected: 200 status code\nActual: %d status code", resp.StatusCode)
+		return
+	}
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	expected := tournamentProviderID
+	actual := providerID
+	if expected != actual {
+		t.Errorf("\nExpected: %d\nActual: %d\n", expected, actual)
+		return
+	}
+}
+
+func TestStubTournaments(t *testing.T) {
+	rec, err := recorder.New("cassettes/lol/tournament-stub-v4/tournaments")
+	if err != nil {
+		log.Fatal(err)
+	} else {
+		rec.SetMatcher(matchWithoutToken)
+		httpClient = &http.Client{Transport: rec}
+	}
+	rec.AddFilter(removeToken)
+	defer rec.Stop()
+	cli, err := NewClient(testToken, WithHTTPClient(httpClient), WithTournamentStub())
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	body := &TournamentRegistrationParameters{ProviderID: tournamentProviderID, Name: "ilikeduck cup"}
+	id, resp, err := cli.Tournaments(body)
+	if resp.StatusCode != 200 {
+		t.Errorf("\nExpected: 200 status code\nActual: %d status code", resp.StatusCode)
+		return
+	}
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	expected := tournamentID
+	actual := id
+	if expected != actual {
+		t.Errorf("\nExpected: %d\nActual: %d\n", expected, actual)
+		return
+	}
+}
+
+func TestProviders(t *testing.T) {
+	rec, err := recorder.New("cassettes/lol/tournament-v4/providers")
+	if err != nil {
+		log.Fatal(err)
+	} else {
+		rec.SetMatcher(matchWithoutToken)
+		httpClient = &http.Client{Transport: rec}
+	}
+	rec.AddFilter(removeToken)
+	defer rec.Stop()
+	cli, err := NewClient(testToken, WithHTTPClient(httpClient))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	body := &ProviderRegistrationParameters{Region: "NA", URL: "https://example.com/callback"}
+	providerID, resp, err := cli.Providers(body)
+	if resp.StatusCode != 200 {
+		t.Errorf("\nExpected: 200 status code\nActual: %d status code", resp.StatusCode)
+		return
+	}
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	expected := tournamentProviderID
+	actual := providerID
+	if expected != actual {
+		t.Errorf("\nExpected: %d\nActual: %d\n", expected, actual)
+		return
+	}
+}
+
+func TestCode(t *testing.T) {
+	rec, err := recorder.New("cassettes/lol/tournament-v4/code")
+	if err != nil {
+		log.Fatal(err)
+	} else {
+		rec.SetMatcher(matchWithoutToken)
+		httpClient = &http.Client{Transport: rec}
+	}
+	rec.AddFilter(removeToken)
+	defer rec.Stop()
+	cli, err := NewClient(testToken, WithHTTPClient(httpClient))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	dto, resp, err := cli.Code(tournamentCode)
+	if resp.StatusCode != 200 {
+		t.Errorf("\nExpected: 200 status code\nActual: %d status code", resp.StatusCode)
+		return
+	}
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	expected := tournamentID
+	actual := dto.TournamentID
+	if expected != actual {
+		t.Errorf("\nExpected: %d\nActual: %d\n", expected, actual)
+		return
+	}
+}
+
+func TestUpdateCode(t *testing.T) {
+	rec, err := recorder.New("cassettes/lol/tournament-v4/update-code")
+	if err != nil {
+		log.Fatal(err)
+	} else {
+		rec.SetMatcher(matchWithoutToken)
+		httpClient = &http.Client{Transport: rec}
+	}
+	rec.AddFilter(removeToken)
+	defer rec.Stop()
+	cli, err := NewClient(testToken, WithHTTPClient(httpClient))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	body := &TournamentCodeUpdateParameters{
+		PickType:      PickTypeBlindPick,
+		MapType:       MapTypeHowlingAbyss,
+		SpectatorType: SpectatorTypeLobbyOnly,
+	}
+	resp, err := cli.UpdateCode(tournamentCode, body)
+	if resp.StatusCode != 200 {
+		t.Errorf("\nExpected: 200 status code\nActual: %d status code", resp.StatusCode)
+		return
+	}
+	if err != nil {
+		t.Error(err)
+		return
+	}
+}
+
+func TestUpdateCodePartialBody(t *testing.T) {
+	body := &TournamentCodeUpdateParameters{AllowedSummonerIDs: []string{encryptedSummonerID}}
+	b, err := json.Marshal(body)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	expected := `{"allowedSummonerIds":["` + encryptedSummonerID + `"]}`
+	actual := string(b)
+	if expected != actual {
+		t.Errorf("\nExpected: %s\nActual: %s\n", expected, actual)
+		return
+	}
+}
@@ -0,0 +1,389 @@
+package lol
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/dghubble/sling"
+)
+
+const (
+	// defaultValShard serves platforms missing from valShards
+	defaultValShard = "na"
+	// maxValLeaderboardSize is the largest page val-ranked-v1 returns
+	maxValLeaderboardSize = 200
+)
+
+var (
+	// valShards maps a platform to the VALORANT shard that serves it
+	valShards = map[string]string{
+		"na1":  "na",
+		"br1":  "br",
+		"la1":  "latam",
+		"la2":  "latam",
+		"eun1": "eu",
+		"euw1": "eu",
+		"ru":   "eu",
+		"tr1":  "eu",
+		"kr":   "kr",
+		"jp1":  "ap",
+		"oc1":  "ap",
+		"ph2":  "ap",
+		"sg2":  "ap",
+		"th2":  "ap",
+		"tw2":  "ap",
+		"vn2":  "ap",
+	}
+)
+
+// VAL provides methods to interface with VALORANT resource
+type VAL struct {
+	sling *sling.Sling
+}
+
+type VALContentsParams struct {
+	Locale string `url:"locale,omitempty"`
+}
+
+type VALLeaderboardParams struct {
+	Size       int `url:"size,omitempty"`
+	StartIndex int `url:"startIndex,omitempty"`
+}
+
+type VALContentDTO struct {
+	Version      string              `json:"version"`
+	Characters   []VALContentItemDTO `json:"characters"`
+	Maps         []VALContentItemDTO `json:"maps"`
+	Chromas      []VALContentItemDTO `json:"chromas"`
+	Skins        []VALContentItemDTO `json:"skins"`
+	SkinLevels   []VALContentItemDTO `json:"skinLevels"`
+	Equips       []VALContentItemDTO `json:"equips"`
+	GameModes    []VALContentItemDTO `json:"gameModes"`
+	Sprays       []VALContentItemDTO `json:"sprays"`
+	SprayLevels  []VALContentItemDTO `json:"sprayLevels"`
+	Charms       []VALContentItemDTO `json:"charms"`
+	CharmLevels  []VALContentItemDTO `json:"charmLevels"`
+	PlayerCards  []VALContentItemDTO `json:"playerCards"`
+	PlayerTitles []VALContentItemDTO `json:"playerTitles"`
+	Acts         []VALActDTO         `json:"acts"`
+}
+
+type VALContentItemDTO struct {
+	Name           string            `json:"name"`
+	LocalizedNames map[string]string `json:"localizedNames"`
+	ID             string            `json:"id"`
+	AssetName      string            `json:"assetName"`
+	AssetPath      string            `json:"assetPath"`
+}
+
+type VALActDTO struct {
+	Name           string            `json:"name"`
+	LocalizedNames map[string]string `json:"localizedNames"`
+	ID             string            `json:"id"`
+	IsActive       bool              `json:"isActive"`
+	ParentID       string            `json:"parentId"`
+	Type           string            `json:"type"`
+}
+
+type VALLeaderboardDTO struct {
+	Shard        string                    `json:"shard"`
+	ActID        string                    `json:"actId"`
+	TotalPlayers int64                     `json:"totalPlayers"`
+	Players      []VALLeaderboardPlayerDTO `json:"players"`
+}
+
+type VALLeaderboardPlayerDTO struct {
+	Puuid           string `json:"puuid"`
+	GameName        string `json:"gameName"`
+	TagLine         string `json:"tagLine"`
+	LeaderboardRank int64  `json:"leaderboardRank"`
+	RankedRating    int64  `json:"rankedRating"`
+	NumberOfWins    int64  `json:"numberOfWins"`
+	CompetitiveTier int64  `json:"competitiveTier"`
+}
+
+type VALMatchlistDTO struct {
+	Puuid   string                 `json:"puuid"`
+	History []VALMatchlistEntryDTO `json:"history"`
+}
+
+type VALMatchlistEntryDTO struct {
+	MatchID             string `json:"matchId"`
+	GameStartTimeMillis int64  `json:"gameStartTimeMillis"`
+	QueueID             string `json:"queueId"`
+}
+
+type VALMatchDTO struct {
+	MatchInfo    VALMatchInfoDTO     `json:"matchInfo"`
+	Players      []VALPlayerDTO      `json:"players"`
+	Coaches      []VALCoachDTO       `json:"coaches"`
+	Teams        []VALTeamDTO        `json:"teams"`
+	RoundResults []VALRoundResultDTO `json:"roundResults"`
+}
+
+type VALMatchInfoDTO struct {
+	MatchID            string `json:"matchId"`
+	MapID              string `json:"mapId"`
+	GameLengthMillis   int64  `json:"gameLengthMillis"`
+	GameStartMillis    int64  `json:"gameStartMillis"`
+	ProvisioningFlowID string `json:"provisioningFlowId"`
+	IsCompleted        bool   `json:"isCompleted"`
+	CustomGameName     string `json:"customGameName"`
+	QueueID            string `json:"queueId"`
+	GameMode           string `json:"gameMode"`
+	IsRanked           bool   `json:"isRanked"`
+	SeasonID           string `json:"seasonId"`
+}
+
+type VALPlayerDTO struct {
+	Puuid           string            `json:"puuid"`
+	GameName        string            `json:"gameName"`
+	TagLine         string            `json:"tagLine"`
+	TeamID          string            `json:"teamId"`
+	PartyID         string            `json:"partyId"`
+	CharacterID     string            `json:"characterId"`
+	Stats           VALPlayerStatsDTO `json:"stats"`
+	CompetitiveTier int               `json:"competitiveTier"`
+	PlayerCard      string            `json:"playerCard"`
+	PlayerTitle     string            `json:"playerTitle"`
+}
+
+type VALPlayerStatsDTO struct {
+	Score          int                `json:"score"`
+	RoundsPlayed   int                `json:"roundsPlayed"`
+	Kills          int                `json:"kills"`
+	Deaths         int                `json:"deaths"`
+	Assists        int                `json:"assists"`
+	PlaytimeMillis int64              `json:"playtimeMillis"`
+	AbilityCasts   VALAbilityCastsDTO `json:"abilityCasts"`
+}
+
+type VALAbilityCastsDTO struct {
+	GrenadeCasts  int `json:"grenadeCasts"`
+	Ability1Casts int `json:"ability1Casts"`
+	Ability2Casts int `json:"ability2Casts"`
+	UltimateCasts int `json:"ultimateCasts"`
+}
+
+type VALCoachDTO struct {
+	Puuid  string `json:"puuid"`
+	TeamID string `json:"teamId"`
+}
+
+type VALTeamDTO struct {
+	TeamID       string `json:"teamId"`
+	Won          bool   `json:"won"`
+	RoundsPlayed int    `json:"roundsPlayed"`
+	RoundsWon    int    `json:"roundsWon"`
+	NumPoints    int    `json:"numPoints"`
+}
+
+type VALRoundResultDTO struct {
+	RoundNum              int                      `json:"roundNum"`
+	RoundResult           string                   `json:"roundResult"`
+	RoundCeremony         string                   `json:"roundCeremony"`
+	WinningTeam           string                   `json:"winningTeam"`
+	BombPlanter           string                   `json:"bombPlanter"`
+	BombDefuser           string                   `json:"bombDefuser"`
+	PlantRoundTime        int                      `json:"plantRoundTime"`
+	PlantPlayerLocations  []VALPlayerLocationsDTO  `json:"plantPlayerLocations"`
+	PlantLocation         VALLocationDTO           `json:"plantLocation"`
+	PlantSite             string                   `json:"plantSite"`
+	DefuseRoundTime       int                      `json:"defuseRoundTime"`
+	DefusePlayerLocations []VALPlayerLocationsDTO  `json:"defusePlayerLocations"`
+	DefuseLocation        VALLocationDTO           `json:"defuseLocation"`
+	PlayerStats           []VALPlayerRoundStatsDTO `json:"playerStats"`
+	RoundResultCode       string                   `json:"roundResultCode"`
+}
+
+type VALPlayerLocationsDTO struct {
+	Puuid       string         `json:"puuid"`
+	ViewRadians float64        `json:"viewRadians"`
+	Location    VALLocationDTO `json:"location"`
+}
+
+type VALLocationDTO struct {
+	X int `json:"x"`
+	Y int `json:"y"`
+}
+
+type VALPlayerRoundStatsDTO struct {
+	Puuid   string         `json:"puuid"`
+	Kills   []VALKillDTO   `json:"kills"`
+	Damage  []VALDamageDTO `json:"damage"`
+	Score   int            `json:"score"`
+	Economy VALEconomyDTO  `json:"economy"`
+	Ability VALAbilityDTO  `json:"ability"`
+}
+
+type VALKillDTO struct {
+	TimeSinceGameStartMillis  int64                   `json:"timeSinceGameStartMillis"`
+	TimeSinceRoundStartMillis int64                   `json:"timeSinceRoundStartMillis"`
+	Killer                    string                  `json:"killer"`
+	Victim                    string                  `json:"victim"`
+	VictimLocation            VALLocationDTO          `json:"victimLocation"`
+	Assistants                []string                `json:"assistants"`
+	PlayerLocations           []VALPlayerLocationsDTO `json:"playerLocations"`
+	FinishingDamage           VALFinishingDamageDTO   `json:"finishingDamage"`
+}
+
+type VALFinishingDamageDTO struct {
+	DamageType          string `json:"damageType"`
+	DamageItem          string `json:"damageItem"`
+	IsSecondaryFireMode bool   `json:"isSecondaryFireMode"`
+}
+
+type VALDamageDTO struct {
+	Receiver  string `json:"receiver"`
+	Damage    int    `json:"damage"`
+	Legshots  int    `json:"legshots"`
+	Bodyshots int    `json:"bodyshots"`
+	Headshots int    `json:"headshots"`
+}
+
+type VALEconomyDTO struct {
+	LoadoutValue int    `json:"loadoutValue"`
+	Weapon       string `json:"weapon"`
+	Armor        string `json:"armor"`
+	Remaining    int    `json:"remaining"`
+	Spent        int    `json:"spent"`
+}
+
+type VALAbilityDTO struct {
+	GrenadeEffects  string `json:"grenadeEffects"`
+	Ability1Effects string `json:"ability1Effects"`
+	Ability2Effects string `json:"ability2Effects"`
+	UltimateEffects string `json:"ultimateEffects"`
+}
+
+// NewVAL returns a new VAL, sling must be based on a VALORANT shard host
+func NewVAL(sling *sling.Sling) *VAL {
+	return &VAL{sling: sling.New().Path("val/")}
+}
+
+// Contents GET /val/content/v1/contents
+func (v *VAL) Contents(params *VALContentsParams) (*VALContentDTO, *http.Response, error) {
+	dto := new(VALContentDTO)
+	var reqErr error
+	resp, err := v.sling.New().Get("content/v1/contents").QueryStruct(params).Receive(dto, reqErr)
+	if err != nil {
+		return nil, resp, err
+	}
+	return dto, resp, reqErr
+}
+
+// LeaderboardByAct GET /val/ranked/v1/leaderboards/by-act/{actId}
+func (v *VAL) LeaderboardByAct(actID string, params *VALLeaderboardParams) (*VALLeaderboardDTO, *http.Response, error) {
+	dto := new(VALLeaderboardDTO)
+	var reqErr error
+	resp, err := v.sling.New().Get("ranked/v1/leaderboards/by-act/"+actID).QueryStruct(params).Receive(dto, reqErr)
+	if err != nil {
+		return nil, resp, err
+	}
+	return dto, resp, reqErr
+}
+
+// LeaderboardTop pages through LeaderboardByAct until it has the top n players of the act
+// or the leaderboard runs out. It stops at the first response that is not 200 OK.
+func (v *VAL) LeaderboardTop(actID string, n int) ([]VALLeaderboardPlayerDTO, *http.Response, error) {
+	var players []VALLeaderboardPlayerDTO
+	var resp *http.Response
+	for len(players) < n {
+		size := n - len(players)
+		if size > maxValLeaderboardSize {
+			size = maxValLeaderboardSize
+		}
+		page, pageResp, err := v.LeaderboardByAct(actID, &VALLeaderboardParams{Size: size, StartIndex: len(players)})
+		resp = pageResp
+		if err != nil {
+			return players, resp, err
+		}
+		if resp.StatusCode != http.StatusOK {
+			return players, resp, fmt.Errorf("lol: leaderboard request failed: %s", resp.Status)
+		}
+		players = append(players, page.Players...)
+		if len(page.Players) < size || int64(len(players)) >= page.TotalPlayers {
+			break
+		}
+	}
+	return players, resp, nil
+}
+
+// MatchlistByPUUID GET /val/match/v1/matchlists/by-puuid/{puuid}
+func (v *VAL) MatchlistByPUUID(puuid string) (*VALMatchlistDTO, *http.Response, error) {
+	dto := new(VALMatchlistDTO)
+	var reqErr error
+	resp, err := v.sling.New().Get("match/v1/matchlists/by-puuid/"+puuid).Receive(dto, reqErr)
+	if err != nil {
+		return nil, resp, err
+	}
+	return dto, resp, reqErr
+}
+
+// MatchByID GET /val/match/v1/matches/{matchId}
+func (v *VAL) MatchByID(matchID string) (*VALMatchDTO, *http.Response, error) {
+	dto := new(VALMatchDTO)
+	var reqErr error
+	resp, err := v.sling.New().Get("match/v1/matches/"+matchID).Receive(dto, reqErr)
+	if err != nil {
+		return nil, resp, err
+	}
+	return dto, resp, reqErr
+}
+
+// ActiveAct returns the act currently running or nil when there is none
+func (c *VALContentDTO) ActiveAct() *VALActDTO {
+	for i := range c.Acts {
+		if c.Acts[i].IsActive && strings.EqualFold(c.Acts[i].Type, "act") {
+			return &c.Acts[i]
+		}
+	}
+	return nil
+}
+
+// Agent returns the agent with the given character ID, IDs compare case insensitively
+// because match-v1 reports them in upper case and content-v1 in lower case.
+func (c *VALContentDTO) Agent(characterID string) *VALContentItemDTO {
+	return valContentItem(c.Characters, characterID)
+}
+
+// Map returns the map with the given ID or asset path, match-v1 reports maps by asset path
+func (c *VALContentDTO) Map(mapID string) *VALContentItemDTO {
+	for i := range c.Maps {
+		if c.Maps[i].AssetPath == mapID {
+			return &c.Maps[i]
+		}
+	}
+	return valContentItem(c.Maps, mapID)
+}
+
+func valContentItem(items []VALContentItemDTO, id string) *VALContentItemDTO {
+	for i := range items {
+		if strings.EqualFold(items[i].ID, id) {
+			return &items[i]
+		}
+	}
+	return nil
+}
+
+// isVALShard reports whether shard is a VALORANT shard, the esports shard included
+func isVALShard(shard string) bool {
+	if shard == "esports" {
+		return true
+	}
+	for _, s := range valShards {
+		if s == shard {
+			return true
+		}
+	}
+	return false
+}
+
+// valShard returns the VALORANT shard serving region, defaulting to na
+func valShard(region string) string {
+	if shard, ok := valShards[strings.ToLower(region)]; ok {
+		return shard
+	}
+	return defaultValShard
+}
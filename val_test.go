@@ -0,0 +1,241 @@
+package lol
+
+import (
+	"log"
+	"net/http"
+	"testing"
+
+	"github.com/dnaeon/go-vcr/recorder"
+)
+
+var (
+	valActID   = "a16955a5-4ad0-f761-5e9e-389df1c892fb"
+	valMatchID = "7a6e4f01-2c3b-4d5e-8f9a-0b1c2d3e4f50"
+)
+
+func TestVALContents(t *testing.T) {
+	rec, err := recorder.New("cassettes/val/content-v1/contents")
+	if err != nil {
+		log.Fatal(err)
+	} else {
+		rec.SetMatcher(matchWithoutToken)
+		httpClient = &http.Client{Transport: rec}
+	}
+	rec.AddFilter(removeToken)
+	defer rec.Stop()
+	cli, err := NewClient(testToken, WithHTTPClient(httpClient))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	dto, resp, err := cli.VAL.Contents(&VALContentsParams{Locale: "en-US"})
+	if resp.StatusCode != 200 {
+		t.Errorf("\nExpected: 200 status code\nActual: %d status code", resp.StatusCode)
+		return
+	}
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	expected := "ACT III"
+	actual := dto.ActiveAct().Name
+	if expected != actual {
+		t.Errorf("\nExpected: %s\nActual: %s\n", expected, actual)
+		return
+	}
+}
+
+func TestVALLeaderboardByAct(t *testing.T) {
+	rec, err := recorder.New("cassettes/val/ranked-v1/leaderboard-by-act")
+	if err != nil {
+		log.Fatal(err)
+	} else {
+		rec.SetMatcher(matchWithoutToken)
+		httpClient = &http.Client{Transport: rec}
+	}
+	rec.AddFilter(removeToken)
+	defer rec.Stop()
+	cli, err := NewClient(testToken, WithHTTPClient(httpClient))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	dto, resp, err := cli.VAL.LeaderboardByAct(valActID, &VALLeaderboardParams{Size: 10, StartIndex: 20})
+	if resp.StatusCode != 200 {
+		t.Errorf("\nExpected: 200 status code\nActual: %d status code", resp.StatusCode)
+		return
+	}
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	var expected int64
+	expected = 21
+	actual := dto.Players[0].LeaderboardRank
+	if expected != actual {
+		t.Errorf("\nExpected: %d\nActual: %d\n", expected, actual)
+		return
+	}
+}
+
+func TestVALLeaderboardTop(t *testing.T) {
+	rec, err := recorder.New("cassettes/val/ranked-v1/leaderboard-top")
+	if err != nil {
+		log.Fatal(err)
+	} else {
+		rec.SetMatcher(matchWithoutToken)
+		httpClient = &http.Client{Transport: rec}
+	}
+	rec.AddFilter(removeToken)
+	defer rec.Stop()
+	cli, err := NewClient(testToken, WithHTTPClient(httpClient))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	players, resp, err := cli.VAL.LeaderboardTop(valActID, 250)
+	if resp.StatusCode != 200 {
+		t.Errorf("\nExpected: 200 status code\nActual: %d status code", resp.StatusCode)
+		return
+	}
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	expected := 230
+	actual := len(players)
+	if expected != actual {
+		t.Errorf("\nExpected: %d\nActual: %d\n", expected, actual)
+		return
+	}
+}
+
+func TestVALMatchlistByPUUID(t *testing.T) {
+	rec, err := recorder.New("cassettes/val/match-v1/matchlist")
+	if err != nil {
+		log.Fatal(err)
+	} else {
+		rec.SetMatcher(matchWithoutToken)
+		httpClient = &http.Client{Transport: rec}
+	}
+	rec.AddFilter(removeToken)
+	defer rec.Stop()
+	cli, err := NewClient(testToken, WithHTTPClient(httpClient))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	dto, resp, err := cli.VAL.MatchlistByPUUID(encryptedPUUID)
+	if resp.StatusCode != 200 {
+		t.Errorf("\nExpected: 200 status code\nActual: %d status code", resp.StatusCode)
+		return
+	}
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	expected := valMatchID
+	actual := dto.History[0].MatchID
+	if expected != actual {
+		t.Errorf("\nExpected: %s\nActual: %s\n", expected, actual)
+		return
+	}
+}
+
+func TestVALMatchByID(t *testing.T) {
+	rec, err := recorder.New("cassettes/val/match-v1/match")
+	if err != nil {
+		log.Fatal(err)
+	} else {
+		rec.SetMatcher(matchWithoutToken)
+		httpClient = &http.Client{Transport: rec}
+	}
+	rec.AddFilter(removeToken)
+	defer rec.Stop()
+	cli, err := NewClient(testToken, WithHTTPClient(httpClient))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	dto, resp, err := cli.VAL.MatchByID(valMatchID)
+	if resp.StatusCode != 200 {
+		t.Errorf("\nExpected: 200 status code\nActual: %d status code", resp.StatusCode)
+		return
+	}
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	expected := 20
+	actual := dto.Players[0].Stats.Kills
+	if expected != actual {
+		t.Errorf("\nExpected: %d\nActual: %d\n", expected, actual)
+		return
+	}
+}
+
+func TestValShard(t *testing.T) {
+	tests := []struct {
+		region, shard string
+	}{
+		{"na1", "na"},
+		{"EUW1", "eu"},
+		{"kr", "kr"},
+		{"oc1", "ap"},
+		{"la2", "latam"},
+		{"mynewregion", "na"},
+	}
+	for _, test := range tests {
+		expected := test.shard
+		actual := valShard(test.region)
+		if expected != actual {
+			t.Errorf("\nExpected: %s\nActual: %s\n", expected, actual)
+			return
+		}
+	}
+}
+
+func TestWithVALShard(t *testing.T) {
+	cli, err := NewClient(testToken, WithVALShard("EU"))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	req, err := cli.VAL.sling.New().Request()
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	expected := "https://eu.api.riotgames.com/val/"
+	actual := req.URL.String()
+	if expected != actual {
+		t.Errorf("\nExpected: %s\nActual: %s\n", expected, actual)
+		return
+	}
+
+	req, err = cli.VALForShard("ap").sling.New().Request()
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	expected = "https://ap.api.riotgames.com/val/"
+	actual = req.URL.String()
+	if expected != actual {
+		t.Errorf("\nExpected: %s\nActual: %s\n", expected, actual)
+		return
+	}
+	if expected, actual := testToken, req.Header.Get("X-Riot-Token"); expected != actual {
+		t.Errorf("\nExpected: %s\nActual: %s\n", expected, actual)
+		return
+	}
+
+	if _, err := NewClient(testToken, WithVALShard("euw1")); err == nil {
+		t.Error("\nExpected: error for a platform given as shard\nActual: nil")
+		return
+	}
+}
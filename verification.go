@@ -0,0 +1,132 @@
+package lol
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	verificationCodeLength   = 8
+	verificationCodeAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+	defaultVerifyInterval    = 2 * time.Second
+	defaultVerifyMaxInterval = 30 * time.Second
+	defaultVerifyTimeout     = 5 * time.Minute
+)
+
+var (
+	// ErrVerificationTimeout returned when the third party code did not match before the timeout
+	ErrVerificationTimeout = errors.New("lol: third party code verification timed out")
+)
+
+// VerifyOptions controls how VerifyThirdPartyCode polls
+type VerifyOptions struct {
+	// Interval is the wait before the first retry, doubled after every miss
+	Interval time.Duration
+	// MaxInterval caps the wait between retries
+	MaxInterval time.Duration
+	// Timeout bounds the whole verification attempt, including a request in flight
+	Timeout time.Duration
+}
+
+// NewVerificationCode returns a random code for the user to enter in the League client.
+// Similar looking characters are left out so the code is easy to type.
+func NewVerificationCode() (string, error) {
+	b := make([]byte, verificationCodeLength)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	for i := range b {
+		b[i] = verificationCodeAlphabet[int(b[i])%len(verificationCodeAlphabet)]
+	}
+	return string(b), nil
+}
+
+// VerifyThirdPartyCode polls the third party code of encryptedSummonerID with exponential backoff
+// until it matches code. It returns nil once the code matches, ErrVerificationTimeout when
+// options.Timeout elapses first and ctx.Err() when ctx is cancelled. A nil options uses defaults.
+func (l *LOL) VerifyThirdPartyCode(ctx context.Context, encryptedSummonerID, code string, options *VerifyOptions) error {
+	opts := VerifyOptions{
+		Interval:    defaultVerifyInterval,
+		MaxInterval: defaultVerifyMaxInterval,
+		Timeout:     defaultVerifyTimeout,
+	}
+	if options != nil {
+		if options.Interval > 0 {
+			opts.Interval = options.Interval
+		}
+		if options.MaxInterval > 0 {
+			opts.MaxInterval = options.MaxInterval
+		}
+		if options.Timeout > 0 {
+			opts.Timeout = options.Timeout
+		}
+	}
+
+	parent := ctx
+	ctx, cancel := context.WithTimeout(ctx, opts.Timeout)
+	defer cancel()
+	// timedOut tells the options.Timeout elapsing from the caller's ctx ending
+	timedOut := func(err error) error {
+		if parent.Err() == nil && ctx.Err() == context.DeadlineExceeded {
+			return ErrVerificationTimeout
+		}
+		return err
+	}
+	interval := opts.Interval
+	for {
+		if err := ctx.Err(); err != nil {
+			return timedOut(err)
+		}
+		matched, err := l.matchThirdPartyCode(ctx, encryptedSummonerID, code)
+		if err != nil {
+			return timedOut(err)
+		}
+		if matched {
+			return nil
+		}
+
+		wait := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			wait.Stop()
+			return timedOut(ctx.Err())
+		case <-wait.C:
+		}
+		interval *= 2
+		if interval > opts.MaxInterval {
+			interval = opts.MaxInterval
+		}
+	}
+}
+
+// matchThirdPartyCode reports whether the summoner's current third party code equals code.
+// Missing codes, rate limits and server errors are treated as a miss so polling continues.
+func (l *LOL) matchThirdPartyCode(ctx context.Context, encryptedSummonerID, code string) (bool, error) {
+	req, err := l.sling.New().Get(thirdPartyCodePath + encryptedSummonerID).Request()
+	if err != nil {
+		return false, err
+	}
+	current := new(string)
+	resp, err := l.sling.Do(req.WithContext(ctx), current, nil)
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return false, ctxErr
+		}
+		return false, err
+	}
+	switch {
+	case resp.StatusCode == http.StatusOK:
+		return strings.TrimSpace(*current) == code, nil
+	case resp.StatusCode == http.StatusNotFound,
+		resp.StatusCode == http.StatusTooManyRequests,
+		resp.StatusCode >= http.StatusInternalServerError:
+		return false, nil
+	default:
+		return false, fmt.Errorf("lol: third party code request failed: %s", resp.Status)
+	}
+}
@@ -0,0 +1,124 @@
+package lol
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/dnaeon/go-vcr/recorder"
+)
+
+func TestNewVerificationCode(t *testing.T) {
+	code, err := NewVerificationCode()
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	expected := verificationCodeLength
+	actual := len(code)
+	if expected != actual {
+		t.Errorf("\nExpected: %d\nActual: %d\n", expected, actual)
+		return
+	}
+	other, err := NewVerificationCode()
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if code == other {
+		t.Errorf("\nExpected: different codes\nActual: %s twice\n", code)
+		return
+	}
+}
+
+func TestVerifyThirdPartyCode(t *testing.T) {
+	rec, err := recorder.New("cassettes/lol/third-party-code-v4/verify-third-party-code")
+	if err != nil {
+		log.Fatal(err)
+	} else {
+		rec.SetMatcher(matchWithoutToken)
+		httpClient = &http.Client{Transport: rec}
+	}
+	rec.AddFilter(removeToken)
+	defer rec.Stop()
+	cli, err := NewClient(testToken, WithHTTPClient(httpClient))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	opts := &VerifyOptions{Interval: time.Millisecond, MaxInterval: 2 * time.Millisecond, Timeout: time.Second}
+	err = cli.VerifyThirdPartyCode(context.Background(), encryptedSummonerID, "K7PX2MQA", opts)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+}
+
+func TestVerifyThirdPartyCodeTimeout(t *testing.T) {
+	rec, err := recorder.New("cassettes/lol/third-party-code-v4/verify-third-party-code-timeout")
+	if err != nil {
+		log.Fatal(err)
+	} else {
+		rec.SetMatcher(matchWithoutToken)
+		httpClient = &http.Client{Transport: rec}
+	}
+	rec.AddFilter(removeToken)
+	defer rec.Stop()
+	cli, err := NewClient(testToken, WithHTTPClient(httpClient))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	opts := &VerifyOptions{Interval: time.Second, Timeout: 10 * time.Millisecond}
+	expected := ErrVerificationTimeout
+	actual := cli.VerifyThirdPartyCode(context.Background(), encryptedSummonerID, "K7PX2MQA", opts)
+	if expected != actual {
+		t.Errorf("\nExpected: %v\nActual: %v\n", expected, actual)
+		return
+	}
+}
+
+func TestVerifyThirdPartyCodeCancelled(t *testing.T) {
+	cli, err := NewClient(testToken)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	expected := context.Canceled
+	actual := cli.VerifyThirdPartyCode(ctx, encryptedSummonerID, "K7PX2MQA", nil)
+	if expected != actual {
+		t.Errorf("\nExpected: %v\nActual: %v\n", expected, actual)
+		return
+	}
+}
+
+// stalledTransport never answers, requests only end when their context does
+type stalledTransport struct{}
+
+func (stalledTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	<-req.Context().Done()
+	return nil, req.Context().Err()
+}
+
+func TestVerifyThirdPartyCodeTimeoutInFlight(t *testing.T) {
+	cli, err := NewClient(testToken, WithHTTPClient(&http.Client{Transport: stalledTransport{}}))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	opts := &VerifyOptions{Timeout: 10 * time.Millisecond}
+	expected := ErrVerificationTimeout
+	actual := cli.VerifyThirdPartyCode(context.Background(), encryptedSummonerID, "K7PX2MQA", opts)
+	if expected != actual {
+		t.Errorf("\nExpected: %v\nActual: %v\n", expected, actual)
+		return
+	}
+}